@@ -64,3 +64,18 @@ func NewPaginationFromTFE(p *tfe.Pagination) *Pagination {
 		TotalPages:  p.TotalPages,
 	}
 }
+
+// NewPaginationFromNextPrev converts TFE pagination without a total, as returned by
+// organization wide run lists, to our Pagination type. The last page is the one without a
+// next page.
+func NewPaginationFromNextPrev(p *tfe.PaginationNextPrev) *Pagination {
+	totalPages := p.CurrentPage
+	if p.NextPage > p.CurrentPage {
+		totalPages = p.NextPage
+	}
+	return &Pagination{
+		CurrentPage: p.CurrentPage,
+		NextPage:    p.NextPage,
+		TotalPages:  totalPages,
+	}
+}
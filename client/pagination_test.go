@@ -118,3 +118,15 @@ func TestNewPaginationFromTFE(t *testing.T) {
 		t.Errorf("expected TotalPages 5, got %d", pagination.TotalPages)
 	}
 }
+
+func TestNewPaginationFromNextPrev(t *testing.T) {
+	pagination := NewPaginationFromNextPrev(&tfe.PaginationNextPrev{CurrentPage: 2, PreviousPage: 1, NextPage: 3})
+	if pagination.CurrentPage != 2 || pagination.NextPage != 3 || pagination.TotalPages != 3 {
+		t.Errorf("expected more pages after page 2, got %+v", *pagination)
+	}
+
+	pagination = NewPaginationFromNextPrev(&tfe.PaginationNextPrev{CurrentPage: 3, PreviousPage: 2})
+	if pagination.CurrentPage != 3 || pagination.TotalPages != 3 {
+		t.Errorf("expected page 3 to be the last page, got %+v", *pagination)
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+
+	view "github.com/straubt1/tfx/cmd/views"
+)
+
+// runOutcomeExitCodes are the process exit codes for each run outcome when waiting for a run,
+// so CI can branch on the result. Errors unrelated to the run also exit with 1.
+var runOutcomeExitCodes = map[string]int{
+	view.RunOutcomeApplied:            0,
+	view.RunOutcomeErrored:            1,
+	view.RunOutcomePlannedWithChanges: 2,
+	view.RunOutcomePlannedNoChanges:   3,
+	view.RunOutcomeCanceled:           4,
+}
+
+// exitError ends the process with a specific exit code once output has been rendered
+type exitError struct {
+	code int
+}
+
+func (e *exitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+// exitWithCode returns an error that makes Execute exit with code, or nil for 0
+func exitWithCode(code int) error {
+	if code == 0 {
+		return nil
+	}
+	return &exitError{code: code}
+}
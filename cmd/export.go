@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+	"github.com/straubt1/tfx/pkg/hclexport"
+)
+
+var (
+	// `tfx export` command
+	exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export Commands",
+		Long:  "Export existing resources from a TFx Organization.",
+	}
+
+	// `tfx export hcl` command
+	exportHCLCmd = &cobra.Command{
+		Use:   "hcl",
+		Short: "Export resources as Terraform configuration",
+		Long: `Export Projects, Workspaces, Variable Sets, Teams and Registry Modules as tfe provider
+configuration, with import blocks to bring the existing resources under management.
+
+Each Project and its Workspaces are written to their own file. Sensitive values cannot be read
+from the API and are replaced by input variables in variables.tf.`,
+		Example: `
+Export the whole organization:
+tfx export hcl --directory ./tfe-config
+
+Export a single project and its workspaces:
+tfx export hcl --directory ./platform --project-name platform --include projects,workspaces
+
+Export only teams and variable sets:
+tfx export hcl --include teams,variable-sets`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseExportHCLFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return exportHCL(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx export hcl` flags
+	exportHCLCmd.Flags().StringP("directory", "d", "./export", "Directory to write the configuration to (optional, defaults to ./export).")
+	exportHCLCmd.Flags().String("project-name", "", "Only export this Project and its Workspaces (optional).")
+	exportHCLCmd.Flags().StringSlice("include", data.ExportKinds, fmt.Sprintf("Resource kinds to export (optional, one or more of: %s).", strings.Join(data.ExportKinds, ", ")))
+	exportHCLCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportHCLCmd)
+}
+
+func exportHCL(cmdConfig *flags.ExportHCLFlags) error {
+	v := view.NewExportHCLView()
+
+	for _, kind := range cmdConfig.Include {
+		if !slices.Contains(data.ExportKinds, kind) {
+			return v.RenderError(fmt.Errorf("invalid --include value '%s', must be one of: %s", kind, strings.Join(data.ExportKinds, ", ")))
+		}
+	}
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	if cmdConfig.ProjectName != "" {
+		v.PrintCommandHeader("Exporting project '%s' in organization '%s' to '%s'", cmdConfig.ProjectName, c.OrganizationName, cmdConfig.Directory)
+	} else {
+		v.PrintCommandHeader("Exporting organization '%s' to '%s'", c.OrganizationName, cmdConfig.Directory)
+	}
+
+	opts := data.ExportOptions{
+		ProjectName: cmdConfig.ProjectName,
+		Include:     cmdConfig.Include,
+		Parallelism: cmdConfig.Parallelism,
+	}
+	res, err := data.FetchExportResources(c, c.OrganizationName, opts)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read resources"))
+	}
+
+	files := data.BuildHCLExport(res, opts)
+	paths, err := hclexport.WriteFiles(cmdConfig.Directory, files)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to write configuration"))
+	}
+
+	exported := make([]view.ExportedFile, len(files))
+	for i, f := range files {
+		exported[i] = view.ExportedFile{
+			Path:      paths[i],
+			Resources: f.Count("resource"),
+			Imports:   f.Count("import"),
+			Variables: f.Count("variable"),
+		}
+	}
+
+	directory, err := filepath.Abs(cmdConfig.Directory)
+	if err != nil {
+		directory = cmdConfig.Directory
+	}
+	return v.Render(directory, exported)
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ApplyShowFlags holds flags for show apply
+type ApplyShowFlags struct {
+	ID string
+}
+
+// ApplyLogsFlags holds flags for logs apply
+type ApplyLogsFlags struct {
+	ID string
+	LogOutputFlags
+}
+
+func ParseApplyShowFlags(cmd *cobra.Command) (*ApplyShowFlags, error) {
+	return &ApplyShowFlags{ID: viper.GetString("id")}, nil
+}
+
+func ParseApplyLogsFlags(cmd *cobra.Command) (*ApplyLogsFlags, error) {
+	logOutput, err := parseLogOutputFlags()
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyLogsFlags{ID: viper.GetString("id"), LogOutputFlags: logOutput}, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// AssessmentShowFlags holds all flags for the workspace assessment show command
+type AssessmentShowFlags struct {
+	WorkspaceName string
+	FailOnDrift   bool
+}
+
+// DriftReportFlags holds all flags for the report drift command
+type DriftReportFlags struct {
+	WorkspaceSelectorFlags
+	Format       string
+	OutputFile   string
+	OnlyFindings bool
+	Summary      bool
+	FailOnDrift  bool
+	Parallelism  int
+}
+
+// ParseAssessmentShowFlags creates an AssessmentShowFlags from the current command context
+func ParseAssessmentShowFlags(cmd *cobra.Command) (*AssessmentShowFlags, error) {
+	return &AssessmentShowFlags{
+		WorkspaceName: viper.GetString("name"),
+		FailOnDrift:   viper.GetBool("fail-on-drift"),
+	}, nil
+}
+
+// ParseDriftReportFlags creates a DriftReportFlags from the current command context
+func ParseDriftReportFlags(cmd *cobra.Command) (*DriftReportFlags, error) {
+	f := &DriftReportFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Format:                 viper.GetString("format"),
+		OutputFile:             viper.GetString("output-file"),
+		OnlyFindings:           viper.GetBool("only-drifted"),
+		Summary:                viper.GetBool("summary"),
+		FailOnDrift:            viper.GetBool("fail-on-drift"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+	if err := validateReportFormat(f.Format, f.OutputFile); err != nil {
+		return nil, err
+	}
+	if f.Format == "" {
+		f.Format = "table"
+	}
+	return f, nil
+}
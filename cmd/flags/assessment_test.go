@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseDriftReportFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("project-name", "platform")
+	viper.Set("tags", "prod")
+	viper.Set("only-drifted", true)
+
+	got, err := ParseDriftReportFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseDriftReportFlags() error = %v", err)
+	}
+	if got.Format != "table" {
+		t.Errorf("Format = %q, want table", got.Format)
+	}
+	if got.ProjectName != "platform" || got.Tags != "prod" || !got.OnlyFindings {
+		t.Errorf("ParseDriftReportFlags() = %+v", *got)
+	}
+}
+
+func TestParseDriftReportFlagsFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		outputFile string
+		wantErr    bool
+	}{
+		{name: "csv to file", format: "csv", outputFile: "drift.csv"},
+		{name: "json to stdout", format: "json"},
+		{name: "invalid format", format: "xml", wantErr: true},
+		{name: "table to file", format: "table", outputFile: "drift.txt", wantErr: true},
+		{name: "default format to file", outputFile: "drift.txt", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			viper.Set("format", tt.format)
+			viper.Set("output-file", tt.outputFile)
+
+			_, err := ParseDriftReportFlags(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDriftReportFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
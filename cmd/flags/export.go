@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ExportHCLFlags holds all flags for the export hcl command
+type ExportHCLFlags struct {
+	Directory   string
+	ProjectName string
+	Include     []string
+	Parallelism int
+}
+
+// ParseExportHCLFlags creates an ExportHCLFlags from the current command context
+func ParseExportHCLFlags(cmd *cobra.Command) (*ExportHCLFlags, error) {
+	return &ExportHCLFlags{
+		Directory:   viper.GetString("directory"),
+		ProjectName: viper.GetString("project-name"),
+		Include:     viper.GetStringSlice("include"),
+		Parallelism: viper.GetInt("parallelism"),
+	}, nil
+}
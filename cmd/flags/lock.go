@@ -13,7 +13,9 @@ type WorkspaceLockFlags struct {
 }
 
 type WorkspaceLockAllFlags struct {
-	Search string
+	WorkspaceSelectorFlags
+	Reason   string
+	Snapshot string
 }
 
 type WorkspaceUnlockFlags struct {
@@ -21,7 +23,8 @@ type WorkspaceUnlockFlags struct {
 }
 
 type WorkspaceUnlockAllFlags struct {
-	Search string
+	WorkspaceSelectorFlags
+	Restore string
 }
 
 func ParseWorkspaceLockFlags(cmd *cobra.Command) (*WorkspaceLockFlags, error) {
@@ -29,7 +32,11 @@ func ParseWorkspaceLockFlags(cmd *cobra.Command) (*WorkspaceLockFlags, error) {
 }
 
 func ParseWorkspaceLockAllFlags(cmd *cobra.Command) (*WorkspaceLockAllFlags, error) {
-	return &WorkspaceLockAllFlags{Search: viper.GetString("search")}, nil
+	return &WorkspaceLockAllFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Reason:                 viper.GetString("reason"),
+		Snapshot:               viper.GetString("snapshot"),
+	}, nil
 }
 
 func ParseWorkspaceUnlockFlags(cmd *cobra.Command) (*WorkspaceUnlockFlags, error) {
@@ -37,5 +44,38 @@ func ParseWorkspaceUnlockFlags(cmd *cobra.Command) (*WorkspaceUnlockFlags, error
 }
 
 func ParseWorkspaceUnlockAllFlags(cmd *cobra.Command) (*WorkspaceUnlockAllFlags, error) {
-	return &WorkspaceUnlockAllFlags{Search: viper.GetString("search")}, nil
+	return &WorkspaceUnlockAllFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Restore:                viper.GetString("restore"),
+	}, nil
+}
+
+// WorkspaceLockListFlags holds all flags for the workspace lock list command
+type WorkspaceLockListFlags struct {
+	Search       string
+	WildcardName string
+	ProjectID    string
+	Tags         string
+	ExcludeTags  string
+}
+
+// WorkspaceLockShowFlags holds all flags for the workspace lock show command
+type WorkspaceLockShowFlags struct {
+	Name string
+}
+
+// ParseWorkspaceLockListFlags creates a WorkspaceLockListFlags from the current command context
+func ParseWorkspaceLockListFlags(cmd *cobra.Command) (*WorkspaceLockListFlags, error) {
+	return &WorkspaceLockListFlags{
+		Search:       viper.GetString("search"),
+		WildcardName: viper.GetString("wildcard-name"),
+		ProjectID:    viper.GetString("project-id"),
+		Tags:         viper.GetString("tags"),
+		ExcludeTags:  viper.GetString("exclude-tags"),
+	}, nil
+}
+
+// ParseWorkspaceLockShowFlags creates a WorkspaceLockShowFlags from the current command context
+func ParseWorkspaceLockShowFlags(cmd *cobra.Command) (*WorkspaceLockShowFlags, error) {
+	return &WorkspaceLockShowFlags{Name: viper.GetString("name")}, nil
 }
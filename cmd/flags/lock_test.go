@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseWorkspaceLockAllFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("tags", "env:prod")
+	viper.Set("project-name", "platform")
+	viper.Set("reason", "change freeze")
+	viper.Set("snapshot", "freeze.json")
+
+	got, err := ParseWorkspaceLockAllFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseWorkspaceLockAllFlags() error = %v", err)
+	}
+	want := WorkspaceLockAllFlags{
+		WorkspaceSelectorFlags: WorkspaceSelectorFlags{Tags: "env:prod", ProjectName: "platform"},
+		Reason:                 "change freeze",
+		Snapshot:               "freeze.json",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseWorkspaceLockAllFlags() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseWorkspaceUnlockAllFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("search", "app")
+	viper.Set("restore", "freeze.json")
+
+	got, err := ParseWorkspaceUnlockAllFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseWorkspaceUnlockAllFlags() error = %v", err)
+	}
+	want := WorkspaceUnlockAllFlags{
+		WorkspaceSelectorFlags: WorkspaceSelectorFlags{Search: "app"},
+		Restore:                "freeze.json",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseWorkspaceUnlockAllFlags() = %+v, want %+v", *got, want)
+	}
+}
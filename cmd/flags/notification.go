@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NotificationTriggers are the events a notification configuration can be triggered by
+var NotificationTriggers = []string{
+	"run:created",
+	"run:planning",
+	"run:needs_attention",
+	"run:applying",
+	"run:completed",
+	"run:errored",
+	"assessment:drifted",
+	"assessment:failed",
+	"assessment:check_failure",
+	"workspace:auto_destroy_reminder",
+	"workspace:auto_destroy_run_results",
+	"change_request:created",
+}
+
+// NotificationDestinationTypes are the supported notification destinations
+var NotificationDestinationTypes = []string{"slack", "microsoft-teams", "email", "generic"}
+
+// NotificationListFlags holds all flags for the notification list command
+type NotificationListFlags struct {
+	WorkspaceName string
+}
+
+// NotificationCreateFlags holds all flags for the notification create command
+type NotificationCreateFlags struct {
+	WorkspaceName    string
+	NotificationName string
+	DestinationType  string
+	URL              string
+	Token            string
+	Triggers         []string
+	EmailAddresses   []string
+	Enabled          bool
+}
+
+// NotificationUpdateFlags holds all flags for the notification update command.
+// Fields are nil when the flag was not supplied so only supplied values are changed.
+type NotificationUpdateFlags struct {
+	NotificationTargetFlags
+	NewName        *string
+	URL            *string
+	Token          *string
+	Triggers       []string
+	EmailAddresses []string
+	Enabled        *bool
+}
+
+// NotificationTargetFlags identifies a single notification configuration on a workspace,
+// either by name or by ID
+type NotificationTargetFlags struct {
+	WorkspaceName    string
+	NotificationName string
+	ID               string
+}
+
+// NotificationApplyFlags holds all flags for the notification apply command
+type NotificationApplyFlags struct {
+	WorkspaceSelectorFlags
+	Template    string
+	DryRun      bool
+	Parallelism int
+}
+
+// ParseNotificationListFlags creates a NotificationListFlags from the current command context
+func ParseNotificationListFlags(cmd *cobra.Command) (*NotificationListFlags, error) {
+	return &NotificationListFlags{WorkspaceName: viper.GetString("name")}, nil
+}
+
+// ParseNotificationCreateFlags creates a NotificationCreateFlags from the current command context
+func ParseNotificationCreateFlags(cmd *cobra.Command) (*NotificationCreateFlags, error) {
+	f := &NotificationCreateFlags{
+		WorkspaceName:    viper.GetString("name"),
+		NotificationName: viper.GetString("notification-name"),
+		DestinationType:  viper.GetString("destination-type"),
+		URL:              viper.GetString("url"),
+		Token:            hmacTokenFlag(cmd),
+		Triggers:         viper.GetStringSlice("triggers"),
+		EmailAddresses:   viper.GetStringSlice("email-addresses"),
+		Enabled:          viper.GetBool("enabled"),
+	}
+
+	if err := ValidateNotificationDestination(f.DestinationType, f.URL); err != nil {
+		return nil, err
+	}
+	if err := ValidateNotificationTriggers(f.Triggers); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ParseNotificationUpdateFlags creates a NotificationUpdateFlags from the current command context
+func ParseNotificationUpdateFlags(cmd *cobra.Command) (*NotificationUpdateFlags, error) {
+	f := &NotificationUpdateFlags{NotificationTargetFlags: parseNotificationTargetFlags()}
+	if viper.IsSet("new-name") {
+		f.NewName = stringPtr(viper.GetString("new-name"))
+	}
+	if viper.IsSet("url") {
+		f.URL = stringPtr(viper.GetString("url"))
+	}
+	if cmd != nil && cmd.Flags().Changed("hmac-token") {
+		f.Token = stringPtr(hmacTokenFlag(cmd))
+	}
+	if viper.IsSet("triggers") {
+		f.Triggers = viper.GetStringSlice("triggers")
+		if err := ValidateNotificationTriggers(f.Triggers); err != nil {
+			return nil, err
+		}
+	}
+	if viper.IsSet("email-addresses") {
+		f.EmailAddresses = viper.GetStringSlice("email-addresses")
+	}
+	if viper.IsSet("enabled") {
+		enabled := viper.GetBool("enabled")
+		f.Enabled = &enabled
+	}
+
+	if f.NewName == nil && f.URL == nil && f.Token == nil && f.Triggers == nil && f.EmailAddresses == nil && f.Enabled == nil {
+		return nil, errors.New("at least one of --new-name, --url, --hmac-token, --triggers, --email-addresses or --enabled is required")
+	}
+	return f, nil
+}
+
+// ParseNotificationTargetFlags creates a NotificationTargetFlags from the current command context
+func ParseNotificationTargetFlags(cmd *cobra.Command) (*NotificationTargetFlags, error) {
+	f := parseNotificationTargetFlags()
+	return &f, nil
+}
+
+// ParseNotificationApplyFlags creates a NotificationApplyFlags from the current command context
+func ParseNotificationApplyFlags(cmd *cobra.Command) (*NotificationApplyFlags, error) {
+	return &NotificationApplyFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Template:               viper.GetString("template"),
+		DryRun:                 viper.GetBool("dry-run"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
+
+// ValidateNotificationDestination checks the destination type is supported and that a URL is
+// given for destinations that need one
+func ValidateNotificationDestination(destinationType, url string) error {
+	if !slices.Contains(NotificationDestinationTypes, destinationType) {
+		return fmt.Errorf("invalid destination type '%s', must be one of: %s", destinationType, strings.Join(NotificationDestinationTypes, ", "))
+	}
+	if destinationType != "email" && url == "" {
+		return fmt.Errorf("a url is required for %s notifications", destinationType)
+	}
+	return nil
+}
+
+// ValidateNotificationTriggers checks every trigger is a known notification trigger
+func ValidateNotificationTriggers(triggers []string) error {
+	for _, t := range triggers {
+		if !slices.Contains(NotificationTriggers, t) {
+			return fmt.Errorf("invalid trigger '%s', must be one of: %s", t, strings.Join(NotificationTriggers, ", "))
+		}
+	}
+	return nil
+}
+
+func parseNotificationTargetFlags() NotificationTargetFlags {
+	return NotificationTargetFlags{
+		WorkspaceName:    viper.GetString("name"),
+		NotificationName: viper.GetString("notification-name"),
+		ID:               viper.GetString("id"),
+	}
+}
+
+// hmacTokenFlag reads --hmac-token from the command's own flags rather than viper, so the API
+// token from TFE_TOKEN or a profile can never be sent to a webhook as its signing token
+func hmacTokenFlag(cmd *cobra.Command) string {
+	if cmd == nil {
+		return ""
+	}
+	token, _ := cmd.Flags().GetString("hmac-token")
+	return token
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestParseNotificationCreateFlagsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     map[string]interface{}
+		wantErr bool
+	}{
+		{"slack", map[string]interface{}{"destination-type": "slack", "url": "https://hooks.slack.com/x", "triggers": []string{"run:errored"}}, false},
+		{"email without url", map[string]interface{}{"destination-type": "email", "email-addresses": []string{"ops@example.com"}}, false},
+		{"unknown destination", map[string]interface{}{"destination-type": "pagerduty", "url": "https://example.com"}, true},
+		{"webhook without url", map[string]interface{}{"destination-type": "generic"}, true},
+		{"unknown trigger", map[string]interface{}{"destination-type": "slack", "url": "https://hooks.slack.com/x", "triggers": []string{"assessment:drift"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			viper.Set("name", "app-prod")
+			viper.Set("notification-name", "alerts")
+			for k, v := range tt.set {
+				viper.Set(k, v)
+			}
+			_, err := ParseNotificationCreateFlags(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseNotificationCreateFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseNotificationUpdateFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "app-prod")
+	viper.Set("notification-name", "alerts")
+	viper.Set("enabled", false)
+
+	got, err := ParseNotificationUpdateFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseNotificationUpdateFlags() error = %v", err)
+	}
+	enabled := false
+	want := NotificationUpdateFlags{
+		NotificationTargetFlags: NotificationTargetFlags{WorkspaceName: "app-prod", NotificationName: "alerts"},
+		Enabled:                 &enabled,
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseNotificationUpdateFlags() = %+v, want %+v", *got, want)
+	}
+
+	viper.Reset()
+	viper.Set("name", "app-prod")
+	viper.Set("notification-name", "alerts")
+	if _, err := ParseNotificationUpdateFlags(nil); err == nil {
+		t.Errorf("ParseNotificationUpdateFlags() expected error when nothing is updated")
+	}
+}
+
+func TestParseNotificationFlags_HMACToken(t *testing.T) {
+	// The API token is bound to the viper key "token" and must never be used as the HMAC token
+	t.Setenv("TFE_TOKEN", "api-token")
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("hmac-token", "", "")
+		return cmd
+	}
+
+	viper.Reset()
+	viper.BindEnv("token", "TFE_TOKEN")
+	viper.Set("name", "app-prod")
+	viper.Set("notification-name", "drift")
+	viper.Set("destination-type", "generic")
+	viper.Set("url", "https://example.com/hook")
+	viper.Set("enabled", false)
+
+	created, err := ParseNotificationCreateFlags(newCmd())
+	if err != nil {
+		t.Fatalf("ParseNotificationCreateFlags() error = %v", err)
+	}
+	if created.Token != "" {
+		t.Errorf("create Token = %q, want empty without --hmac-token", created.Token)
+	}
+	updated, err := ParseNotificationUpdateFlags(newCmd())
+	if err != nil {
+		t.Fatalf("ParseNotificationUpdateFlags() error = %v", err)
+	}
+	if updated.Token != nil {
+		t.Errorf("update Token = %q, want nil without --hmac-token", *updated.Token)
+	}
+
+	cmd := newCmd()
+	cmd.Flags().Set("hmac-token", "webhook-secret")
+	updated, err = ParseNotificationUpdateFlags(cmd)
+	if err != nil {
+		t.Fatalf("ParseNotificationUpdateFlags() error = %v", err)
+	}
+	if updated.Token == nil || *updated.Token != "webhook-secret" {
+		t.Errorf("update Token = %v, want webhook-secret", updated.Token)
+	}
+}
@@ -4,6 +4,12 @@
 package flags
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -13,9 +19,35 @@ type PlanShowFlags struct {
 	ID string
 }
 
+// LogFormats are the supported plan and apply log output formats
+var LogFormats = []string{"text", "github"}
+
 // PlanLogsFlags holds flags for logs plan
 type PlanLogsFlags struct {
 	ID string
+	LogOutputFlags
+}
+
+// LogOutputFlags holds the flags shared by the plan and apply logs commands
+type LogOutputFlags struct {
+	Diagnostics bool
+	Format      string
+}
+
+// PlanSummaryFormats are the supported plan summary output formats
+var PlanSummaryFormats = []string{"table", "json", "markdown"}
+
+// PlanSummaryFlags holds flags for summary plan
+type PlanSummaryFlags struct {
+	ID     string
+	Format string
+}
+
+// PlanCompareFlags holds flags for compare plan
+type PlanCompareFlags struct {
+	ID         string
+	OtherID    string
+	FailOnDiff bool
 }
 
 // PlanJSONOutputFlags holds flags for jsonoutput plan
@@ -30,8 +62,19 @@ type PlanCreateFlags struct {
 	ConfigurationID string
 	Message         string
 	Speculative     bool
+	PlanOnly        bool
 	Destroy         bool
-	EnvVars         map[string]string
+	RefreshOnly     bool
+	Targets         []string
+	Replaces        []string
+	// Variables are run-scoped Terraform variables from --var and TF_VAR_ entries of --env,
+	// the values are strings
+	Variables map[string]string
+	// HCLVariables are run-scoped Terraform variables from --var-hcl, the values are HCL
+	// expressions
+	HCLVariables map[string]string
+	Wait         bool
+	Timeout      time.Duration
 }
 
 func ParsePlanShowFlags(cmd *cobra.Command) (*PlanShowFlags, error) {
@@ -39,7 +82,56 @@ func ParsePlanShowFlags(cmd *cobra.Command) (*PlanShowFlags, error) {
 }
 
 func ParsePlanLogsFlags(cmd *cobra.Command) (*PlanLogsFlags, error) {
-	return &PlanLogsFlags{ID: viper.GetString("id")}, nil
+	logOutput, err := parseLogOutputFlags()
+	if err != nil {
+		return nil, err
+	}
+	return &PlanLogsFlags{ID: viper.GetString("id"), LogOutputFlags: logOutput}, nil
+}
+
+// parseLogOutputFlags parses --diagnostics and --format, github annotations are only
+// written for diagnostics
+func parseLogOutputFlags() (LogOutputFlags, error) {
+	f := LogOutputFlags{
+		Diagnostics: viper.GetBool("diagnostics"),
+		Format:      viper.GetString("format"),
+	}
+	if f.Format == "" {
+		f.Format = "text"
+	}
+	if !slices.Contains(LogFormats, f.Format) {
+		return f, fmt.Errorf("invalid --format '%s', must be one of: %s", f.Format, strings.Join(LogFormats, ", "))
+	}
+	if f.Format == "github" {
+		f.Diagnostics = true
+	}
+	return f, nil
+}
+
+func ParsePlanSummaryFlags(cmd *cobra.Command) (*PlanSummaryFlags, error) {
+	f := &PlanSummaryFlags{
+		ID:     viper.GetString("id"),
+		Format: viper.GetString("format"),
+	}
+	if f.Format == "" {
+		f.Format = "table"
+	}
+	if !slices.Contains(PlanSummaryFormats, f.Format) {
+		return nil, fmt.Errorf("invalid --format '%s', must be one of: %s", f.Format, strings.Join(PlanSummaryFormats, ", "))
+	}
+	return f, nil
+}
+
+func ParsePlanCompareFlags(cmd *cobra.Command) (*PlanCompareFlags, error) {
+	f := &PlanCompareFlags{
+		ID:         viper.GetString("id"),
+		OtherID:    viper.GetString("other"),
+		FailOnDiff: viper.GetBool("fail-on-diff"),
+	}
+	if f.ID == f.OtherID {
+		return nil, fmt.Errorf("--other must be a different plan than --id")
+	}
+	return f, nil
 }
 
 func ParsePlanJSONOutputFlags(cmd *cobra.Command) (*PlanJSONOutputFlags, error) {
@@ -47,13 +139,73 @@ func ParsePlanJSONOutputFlags(cmd *cobra.Command) (*PlanJSONOutputFlags, error)
 }
 
 func ParsePlanCreateFlags(cmd *cobra.Command) (*PlanCreateFlags, error) {
-	return &PlanCreateFlags{
+	f := &PlanCreateFlags{
 		WorkspaceName:   viper.GetString("name"),
 		Directory:       viper.GetString("directory"),
 		ConfigurationID: viper.GetString("configuration-id"),
 		Message:         viper.GetString("message"),
 		Speculative:     viper.GetBool("speculative"),
+		PlanOnly:        viper.GetBool("plan-only"),
 		Destroy:         viper.GetBool("destroy"),
-		EnvVars:         make(map[string]string), // Will be populated from the env slice in the command
-	}, nil
+		RefreshOnly:     viper.GetBool("refresh-only"),
+		Targets:         viper.GetStringSlice("target"),
+		Replaces:        viper.GetStringSlice("replace"),
+		Variables:       make(map[string]string),
+		HCLVariables:    make(map[string]string),
+		Wait:            viper.GetBool("wait"),
+		Timeout:         viper.GetDuration("timeout"),
+	}
+	if f.Destroy && f.RefreshOnly {
+		return nil, errors.New("--destroy and --refresh-only cannot be used together")
+	}
+
+	for _, v := range viper.GetStringSlice("var") {
+		key, value, err := parseKeyValue("--var", v)
+		if err != nil {
+			return nil, err
+		}
+		f.Variables[key] = value
+	}
+
+	// Only Terraform variables can be scoped to a run, so environment variables must be
+	// TF_VAR_ variables and are passed as the Terraform variable they set
+	for _, e := range viper.GetStringSlice("env") {
+		key, value, err := parseKeyValue("--env", e)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := strings.CutPrefix(key, "TF_VAR_")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("--env '%s' is not supported, only TF_VAR_ environment variables can be set for a single run", key)
+		}
+		if _, exists := f.Variables[name]; exists {
+			return nil, fmt.Errorf("variable '%s' is set by both --var and --env", name)
+		}
+		f.Variables[name] = value
+	}
+
+	for _, v := range viper.GetStringSlice("var-hcl") {
+		key, value, err := parseKeyValue("--var-hcl", v)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("invalid --var-hcl '%s', the value must be an HCL expression", v)
+		}
+		if _, exists := f.Variables[key]; exists {
+			return nil, fmt.Errorf("variable '%s' is set by both --var-hcl and --var or --env", key)
+		}
+		f.HCLVariables[key] = value
+	}
+	return f, nil
+}
+
+// parseKeyValue splits a KEY=VALUE flag value
+func parseKeyValue(flag string, s string) (string, string, error) {
+	key, value, ok := strings.Cut(s, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return "", "", fmt.Errorf("invalid %s '%s', must be KEY=VALUE", flag, s)
+	}
+	return key, value, nil
 }
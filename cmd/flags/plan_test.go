@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParsePlanCreateFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "app-prod")
+	viper.Set("speculative", true)
+	viper.Set("target", []string{"module.network"})
+	viper.Set("var", []string{"region=us-east-1", "account=012345678901", "version=1.10"})
+	viper.Set("var-hcl", []string{"tags={ team = \"platform\" }"})
+	viper.Set("env", []string{"TF_VAR_instance_count=3"})
+
+	got, err := ParsePlanCreateFlags(nil)
+	if err != nil {
+		t.Fatalf("ParsePlanCreateFlags() error = %v", err)
+	}
+	if !got.Speculative || !reflect.DeepEqual(got.Targets, []string{"module.network"}) {
+		t.Errorf("ParsePlanCreateFlags() = %+v", got)
+	}
+	want := map[string]string{
+		"region":         "us-east-1",
+		"account":        "012345678901",
+		"version":        "1.10",
+		"instance_count": "3",
+	}
+	if !reflect.DeepEqual(got.Variables, want) {
+		t.Errorf("Variables = %v, want %v", got.Variables, want)
+	}
+	if want := map[string]string{"tags": `{ team = "platform" }`}; !reflect.DeepEqual(got.HCLVariables, want) {
+		t.Errorf("HCLVariables = %v, want %v", got.HCLVariables, want)
+	}
+
+	invalid := map[string]map[string]interface{}{
+		"var without value":    {"var": []string{"region"}},
+		"env not TF_VAR":       {"env": []string{"AWS_REGION=us-east-1"}},
+		"var set twice":        {"var": []string{"region=a"}, "env": []string{"TF_VAR_region=b"}},
+		"var-hcl and var":      {"var": []string{"tags=a"}, "var-hcl": []string{"tags={}"}},
+		"var-hcl without expr": {"var-hcl": []string{"tags= "}},
+		"destroy refresh-only": {"destroy": true, "refresh-only": true},
+	}
+	for name, values := range invalid {
+		t.Run(name, func(t *testing.T) {
+			viper.Reset()
+			for k, v := range values {
+				viper.Set(k, v)
+			}
+			if _, err := ParsePlanCreateFlags(nil); err == nil {
+				t.Error("ParsePlanCreateFlags() expected error")
+			}
+		})
+	}
+}
+
+func TestParsePlanSummaryFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "plan-abc123")
+
+	got, err := ParsePlanSummaryFlags(nil)
+	if err != nil {
+		t.Fatalf("ParsePlanSummaryFlags() error = %v", err)
+	}
+	if got.Format != "table" {
+		t.Errorf("Format = %q, want table by default", got.Format)
+	}
+
+	viper.Set("format", "html")
+	if _, err := ParsePlanSummaryFlags(nil); err == nil {
+		t.Error("ParsePlanSummaryFlags() with --format html = nil error, want error")
+	}
+}
+
+func TestParsePlanCompareFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "plan-abc123")
+	viper.Set("other", "plan-def456")
+
+	got, err := ParsePlanCompareFlags(nil)
+	if err != nil {
+		t.Fatalf("ParsePlanCompareFlags() error = %v", err)
+	}
+	if got.ID != "plan-abc123" || got.OtherID != "plan-def456" {
+		t.Errorf("ParsePlanCompareFlags() = %+v", got)
+	}
+
+	viper.Set("other", "plan-abc123")
+	if _, err := ParsePlanCompareFlags(nil); err == nil {
+		t.Error("ParsePlanCompareFlags() with the same plan twice = nil error, want error")
+	}
+}
+
+func TestParsePlanLogsFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "plan-abc123")
+	viper.Set("format", "github")
+
+	got, err := ParsePlanLogsFlags(nil)
+	if err != nil {
+		t.Fatalf("ParsePlanLogsFlags() error = %v", err)
+	}
+	if !got.Diagnostics {
+		t.Error("Diagnostics = false, want --format github to imply --diagnostics")
+	}
+
+	viper.Set("format", "gitlab")
+	if _, err := ParsePlanLogsFlags(nil); err == nil {
+		t.Error("ParsePlanLogsFlags() with --format gitlab = nil error, want error")
+	}
+}
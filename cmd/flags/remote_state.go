@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// RemoteStateShowFlags holds all flags for the workspace remote-state show command
+type RemoteStateShowFlags struct {
+	Name string
+}
+
+// RemoteStateSetGlobalFlags holds all flags for the workspace remote-state set-global command
+type RemoteStateSetGlobalFlags struct {
+	Name    string
+	Enabled bool
+}
+
+// RemoteStateConsumerFlags holds all flags for the workspace remote-state add-consumer,
+// remove-consumer and sync commands
+type RemoteStateConsumerFlags struct {
+	Name      string
+	Consumers WorkspaceSelectorFlags
+	DryRun    bool
+}
+
+// RemoteStateReportFlags holds all flags for the workspace remote-state report command
+type RemoteStateReportFlags struct {
+	WorkspaceSelectorFlags
+	GlobalOnly  bool
+	Parallelism int
+}
+
+func ParseRemoteStateShowFlags(cmd *cobra.Command) (*RemoteStateShowFlags, error) {
+	return &RemoteStateShowFlags{Name: viper.GetString("name")}, nil
+}
+
+func ParseRemoteStateSetGlobalFlags(cmd *cobra.Command) (*RemoteStateSetGlobalFlags, error) {
+	return &RemoteStateSetGlobalFlags{
+		Name:    viper.GetString("name"),
+		Enabled: viper.GetBool("enabled"),
+	}, nil
+}
+
+func ParseRemoteStateConsumerFlags(cmd *cobra.Command) (*RemoteStateConsumerFlags, error) {
+	return &RemoteStateConsumerFlags{
+		Name:      viper.GetString("name"),
+		Consumers: parseConsumerSelectorFlags(),
+		DryRun:    viper.GetBool("dry-run"),
+	}, nil
+}
+
+func ParseRemoteStateReportFlags(cmd *cobra.Command) (*RemoteStateReportFlags, error) {
+	return &RemoteStateReportFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		GlobalOnly:             viper.GetBool("global-only"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
+
+// parseConsumerSelectorFlags reads the consumer- prefixed workspace selector flags, used by
+// commands where --name already identifies the workspace being changed
+func parseConsumerSelectorFlags() WorkspaceSelectorFlags {
+	return WorkspaceSelectorFlags{
+		Names:        viper.GetStringSlice("consumer-name"),
+		Search:       viper.GetString("consumer-search"),
+		WildcardName: viper.GetString("consumer-wildcard-name"),
+		Tags:         viper.GetString("consumer-tags"),
+		ExcludeTags:  viper.GetString("consumer-exclude-tags"),
+		ProjectName:  viper.GetString("consumer-project-name"),
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseRemoteStateConsumerFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "network-prod")
+	viper.Set("consumer-name", []string{"app-prod", "db-prod"})
+	viper.Set("consumer-tags", "env:prod")
+	viper.Set("dry-run", true)
+
+	got, err := ParseRemoteStateConsumerFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseRemoteStateConsumerFlags() error = %v", err)
+	}
+	want := RemoteStateConsumerFlags{
+		Name: "network-prod",
+		Consumers: WorkspaceSelectorFlags{
+			Names: []string{"app-prod", "db-prod"},
+			Tags:  "env:prod",
+		},
+		DryRun: true,
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRemoteStateConsumerFlags() = %+v, want %+v", *got, want)
+	}
+}
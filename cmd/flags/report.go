@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ReportFormats are the supported report output formats
+var ReportFormats = []string{"table", "csv", "json"}
+
+// StaleReportFlags holds all flags for the report stale command
+type StaleReportFlags struct {
+	WorkspaceSelectorFlags
+	Days        int
+	Format      string
+	OutputFile  string
+	Parallelism int
+}
+
+// ParseStaleReportFlags creates a StaleReportFlags from the current command context
+func ParseStaleReportFlags(cmd *cobra.Command) (*StaleReportFlags, error) {
+	f := &StaleReportFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Days:                   viper.GetInt("days"),
+		Format:                 viper.GetString("format"),
+		OutputFile:             viper.GetString("output-file"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+	if !viper.IsSet("days") {
+		f.Days = 180
+	}
+	if f.Days < 1 {
+		return nil, fmt.Errorf("--days must be at least 1")
+	}
+	if err := validateReportFormat(f.Format, f.OutputFile); err != nil {
+		return nil, err
+	}
+	if f.Format == "" {
+		f.Format = "table"
+	}
+	return f, nil
+}
+
+// CostReportFlags holds all flags for the report cost command
+type CostReportFlags struct {
+	WorkspaceSelectorFlags
+	Project     bool
+	Format      string
+	OutputFile  string
+	Parallelism int
+}
+
+// ParseCostReportFlags creates a CostReportFlags from the current command context
+func ParseCostReportFlags(cmd *cobra.Command) (*CostReportFlags, error) {
+	f := &CostReportFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Project:                viper.GetBool("project"),
+		Format:                 viper.GetString("format"),
+		OutputFile:             viper.GetString("output-file"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+	if err := validateReportFormat(f.Format, f.OutputFile); err != nil {
+		return nil, err
+	}
+	if f.Format == "" {
+		f.Format = "table"
+	}
+	return f, nil
+}
+
+// validateReportFormat checks the --format flag and that --output-file is only used with a
+// machine readable format
+func validateReportFormat(format string, outputFile string) error {
+	if format != "" && !slices.Contains(ReportFormats, format) {
+		return fmt.Errorf("invalid --format '%s', must be one of: %s", format, strings.Join(ReportFormats, ", "))
+	}
+	if outputFile != "" && (format == "" || format == "table") {
+		return fmt.Errorf("--output-file requires --format csv or json")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseStaleReportFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("project-name", "sandbox")
+
+	got, err := ParseStaleReportFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseStaleReportFlags() error = %v", err)
+	}
+	if got.Days != 180 || got.Format != "table" || got.ProjectName != "sandbox" {
+		t.Errorf("ParseStaleReportFlags() = %+v", *got)
+	}
+
+	viper.Set("days", 0)
+	if _, err := ParseStaleReportFlags(nil); err == nil {
+		t.Errorf("ParseStaleReportFlags() expected error for --days 0")
+	}
+
+	viper.Set("days", 365)
+	viper.Set("output-file", "stale.csv")
+	if _, err := ParseStaleReportFlags(nil); err == nil {
+		t.Errorf("ParseStaleReportFlags() expected error for --output-file without --format")
+	}
+}
+
+func TestParseCostReportFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("project", true)
+	viper.Set("format", "csv")
+
+	got, err := ParseCostReportFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseCostReportFlags() error = %v", err)
+	}
+	if !got.Project || got.Format != "csv" {
+		t.Errorf("ParseCostReportFlags() = %+v", *got)
+	}
+
+	viper.Set("format", "xlsx")
+	if _, err := ParseCostReportFlags(nil); err == nil {
+		t.Errorf("ParseCostReportFlags() expected error for --format xlsx")
+	}
+}
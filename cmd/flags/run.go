@@ -4,14 +4,23 @@
 package flags
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 // RunListFlags holds flags for list runs
 type RunListFlags struct {
-	WorkspaceName string
-	MaxItems      int
+	WorkspaceName     string
+	MaxItems          int
+	NeedsConfirmation bool
+	Interactive       bool
 }
 
 // RunCreateFlags holds flags for create run
@@ -19,6 +28,8 @@ type RunCreateFlags struct {
 	WorkspaceName          string
 	Message                string
 	ConfigurationVersionID string
+	Wait                   bool
+	Timeout                time.Duration
 }
 
 // RunShowFlags holds flags for show run
@@ -26,6 +37,36 @@ type RunShowFlags struct {
 	ID string
 }
 
+// RunWatchFlags holds flags for watch run
+type RunWatchFlags struct {
+	ID      string
+	Timeout time.Duration
+}
+
+// RunTimelineFlags holds flags for timeline run
+type RunTimelineFlags struct {
+	ID string
+}
+
+// RunLogsFlags holds flags for logs run
+type RunLogsFlags struct {
+	ID     string
+	Output string
+}
+
+// RunApplyFlags holds flags for apply run
+type RunApplyFlags struct {
+	ID          string
+	Comment     string
+	Interactive bool
+}
+
+// RunCommentFlags holds flags for comment run
+type RunCommentFlags struct {
+	ID   string
+	Body string
+}
+
 // RunDiscardFlags holds flags for discard run
 type RunDiscardFlags struct {
 	ID string
@@ -37,10 +78,19 @@ type RunCancelFlags struct {
 }
 
 func ParseRunListFlags(cmd *cobra.Command) (*RunListFlags, error) {
-	return &RunListFlags{
-		WorkspaceName: viper.GetString("name"),
-		MaxItems:      viper.GetInt("max-items"),
-	}, nil
+	f := &RunListFlags{
+		WorkspaceName:     viper.GetString("name"),
+		MaxItems:          viper.GetInt("max-items"),
+		NeedsConfirmation: viper.GetBool("needs-confirmation"),
+		Interactive:       viper.GetBool("interactive"),
+	}
+	if f.WorkspaceName == "" && !f.NeedsConfirmation {
+		return nil, errors.New("--name is required unless --needs-confirmation is set")
+	}
+	if f.Interactive && !f.NeedsConfirmation {
+		return nil, errors.New("--interactive requires --needs-confirmation")
+	}
+	return f, nil
 }
 
 func ParseRunCreateFlags(cmd *cobra.Command) (*RunCreateFlags, error) {
@@ -48,6 +98,8 @@ func ParseRunCreateFlags(cmd *cobra.Command) (*RunCreateFlags, error) {
 		WorkspaceName:          viper.GetString("name"),
 		Message:                viper.GetString("message"),
 		ConfigurationVersionID: viper.GetString("configuration-version-id"),
+		Wait:                   viper.GetBool("wait"),
+		Timeout:                viper.GetDuration("timeout"),
 	}, nil
 }
 
@@ -55,6 +107,70 @@ func ParseRunShowFlags(cmd *cobra.Command) (*RunShowFlags, error) {
 	return &RunShowFlags{ID: viper.GetString("id")}, nil
 }
 
+func ParseRunWatchFlags(cmd *cobra.Command) (*RunWatchFlags, error) {
+	return &RunWatchFlags{
+		ID:      viper.GetString("id"),
+		Timeout: viper.GetDuration("timeout"),
+	}, nil
+}
+
+func ParseRunTimelineFlags(cmd *cobra.Command) (*RunTimelineFlags, error) {
+	return &RunTimelineFlags{ID: viper.GetString("id")}, nil
+}
+
+func ParseRunLogsFlags(cmd *cobra.Command) (*RunLogsFlags, error) {
+	return &RunLogsFlags{
+		ID:     viper.GetString("id"),
+		Output: viper.GetString("output"),
+	}, nil
+}
+
+func ParseRunApplyFlags(cmd *cobra.Command) (*RunApplyFlags, error) {
+	return &RunApplyFlags{
+		ID:          viper.GetString("id"),
+		Comment:     viper.GetString("comment"),
+		Interactive: viper.GetBool("interactive"),
+	}, nil
+}
+
+func ParseRunCommentFlags(cmd *cobra.Command) (*RunCommentFlags, error) {
+	f := &RunCommentFlags{
+		ID:   viper.GetString("id"),
+		Body: viper.GetString("body"),
+	}
+	if strings.TrimSpace(f.Body) == "" {
+		return nil, errors.New("--body must not be empty")
+	}
+	return f, nil
+}
+
+// RunPolicyOverrideFlags holds flags for run policy override
+type RunPolicyOverrideFlags struct {
+	ID      string
+	Comment string
+}
+
+func ParseRunPolicyOverrideFlags(cmd *cobra.Command) (*RunPolicyOverrideFlags, error) {
+	f := &RunPolicyOverrideFlags{
+		ID:      viper.GetString("id"),
+		Comment: viper.GetString("comment"),
+	}
+	// The comment is the audit record of why the policies were overridden
+	if strings.TrimSpace(f.Comment) == "" {
+		return nil, errors.New("--comment must not be empty")
+	}
+	return f, nil
+}
+
+// RunCostFlags holds flags for run cost
+type RunCostFlags struct {
+	ID string
+}
+
+func ParseRunCostFlags(cmd *cobra.Command) (*RunCostFlags, error) {
+	return &RunCostFlags{ID: viper.GetString("id")}, nil
+}
+
 func ParseRunDiscardFlags(cmd *cobra.Command) (*RunDiscardFlags, error) {
 	return &RunDiscardFlags{ID: viper.GetString("id")}, nil
 }
@@ -68,12 +184,111 @@ type RunPolicyFlags struct {
 	ID            string
 	WorkspaceName string
 	Logs          bool
+	Format        string
 }
 
+// PolicyFormats are the supported run policy output formats
+var PolicyFormats = []string{"table", "junit", "sarif"}
+
 func ParseRunPolicyFlags(cmd *cobra.Command) (*RunPolicyFlags, error) {
-	return &RunPolicyFlags{
+	f := &RunPolicyFlags{
 		ID:            viper.GetString("id"),
 		WorkspaceName: viper.GetString("name"),
 		Logs:          viper.GetBool("logs"),
-	}, nil
+		Format:        viper.GetString("format"),
+	}
+	if f.Format == "" {
+		f.Format = "table"
+	}
+	if !slices.Contains(PolicyFormats, f.Format) {
+		return nil, fmt.Errorf("invalid --format '%s', must be one of: %s", f.Format, strings.Join(PolicyFormats, ", "))
+	}
+	// Test cases include the policy output
+	if f.Format != "table" {
+		f.Logs = true
+	}
+	return f, nil
+}
+
+// RunSources are the run sources accepted by --source
+var RunSources = []string{"tfe-api", "tfe-ui", "tfe-configuration-version", "terraform+cloud", "terraform"}
+
+// RunOperations are the run operations accepted by --operation
+var RunOperations = []string{"plan-and-apply", "plan-only", "refresh-only", "destroy", "empty-apply", "save-plan"}
+
+// OrgRunListFlags holds flags for listing runs across an organization
+type OrgRunListFlags struct {
+	WorkspaceSelectorFlags
+	Statuses    []string
+	Sources     []string
+	Operations  []string
+	TriggeredBy string
+	Since       time.Time
+	Until       time.Time
+	MaxItems    int
+	Parallelism int
+}
+
+func ParseOrgRunListFlags(cmd *cobra.Command) (*OrgRunListFlags, error) {
+	f := &OrgRunListFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Statuses:               viper.GetStringSlice("status"),
+		Sources:                viper.GetStringSlice("source"),
+		TriggeredBy:            viper.GetString("triggered-by"),
+		MaxItems:               viper.GetInt("max-items"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+
+	for _, s := range f.Sources {
+		if !slices.Contains(RunSources, s) {
+			return nil, fmt.Errorf("invalid --source '%s', must be one of: %s", s, strings.Join(RunSources, ", "))
+		}
+	}
+	// The API names operations with underscores, accept either form
+	for _, o := range viper.GetStringSlice("operation") {
+		o = strings.ReplaceAll(o, "_", "-")
+		if !slices.Contains(RunOperations, o) {
+			return nil, fmt.Errorf("invalid --operation '%s', must be one of: %s", o, strings.Join(RunOperations, ", "))
+		}
+		f.Operations = append(f.Operations, o)
+	}
+
+	now := time.Now()
+	var err error
+	if f.Since, err = parseRunTime(viper.GetString("since"), now); err != nil {
+		return nil, errors.New("invalid --since: " + err.Error())
+	}
+	if f.Until, err = parseRunTime(viper.GetString("until"), now); err != nil {
+		return nil, errors.New("invalid --until: " + err.Error())
+	}
+	if !f.Since.IsZero() && !f.Until.IsZero() && f.Until.Before(f.Since) {
+		return nil, errors.New("--until must be after --since")
+	}
+	if f.MaxItems < 0 {
+		return nil, errors.New("--max-items must not be negative")
+	}
+	return f, nil
+}
+
+// parseRunTime parses a point in time given as an RFC3339 timestamp, a date, or a duration
+// before now such as 90m, 1h or 7d. An empty value returns the zero time.
+func parseRunTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("'%s' must be a duration such as 1h or 7d, a date or an RFC3339 timestamp", value)
 }
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// RunTaskEnforcementLevels are the enforcement levels a run task can be attached with
+var RunTaskEnforcementLevels = []string{"advisory", "mandatory"}
+
+// RunTaskStages are the run stages a run task can be attached to
+var RunTaskStages = []string{"pre_plan", "post_plan", "pre_apply", "post_apply"}
+
+// RunTaskListFlags holds all flags for the run-task list command
+type RunTaskListFlags struct{}
+
+// RunTaskCreateFlags holds all flags for the run-task create command
+type RunTaskCreateFlags struct {
+	Name        string
+	URL         string
+	Description string
+	HMACKey     string
+	Enabled     bool
+}
+
+// RunTaskAttachFlags holds all flags for the run-task attach command
+type RunTaskAttachFlags struct {
+	WorkspaceSelectorFlags
+	TaskName         string
+	EnforcementLevel string
+	Stages           []string
+	Parallelism      int
+}
+
+// RunTaskDetachFlags holds all flags for the run-task detach command
+type RunTaskDetachFlags struct {
+	WorkspaceSelectorFlags
+	TaskName    string
+	Parallelism int
+}
+
+// ParseRunTaskListFlags creates a RunTaskListFlags from the current command context
+func ParseRunTaskListFlags(cmd *cobra.Command) (*RunTaskListFlags, error) {
+	return &RunTaskListFlags{}, nil
+}
+
+// ParseRunTaskCreateFlags creates a RunTaskCreateFlags from the current command context
+func ParseRunTaskCreateFlags(cmd *cobra.Command) (*RunTaskCreateFlags, error) {
+	return &RunTaskCreateFlags{
+		Name:        viper.GetString("name"),
+		URL:         viper.GetString("url"),
+		Description: viper.GetString("description"),
+		HMACKey:     viper.GetString("hmac-key"),
+		Enabled:     viper.GetBool("enabled"),
+	}, nil
+}
+
+// ParseRunTaskAttachFlags creates a RunTaskAttachFlags from the current command context
+func ParseRunTaskAttachFlags(cmd *cobra.Command) (*RunTaskAttachFlags, error) {
+	f := &RunTaskAttachFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		TaskName:               viper.GetString("task-name"),
+		EnforcementLevel:       viper.GetString("enforcement-level"),
+		Stages:                 viper.GetStringSlice("stages"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+
+	if f.EnforcementLevel == "" {
+		f.EnforcementLevel = "advisory"
+	}
+	if !slices.Contains(RunTaskEnforcementLevels, f.EnforcementLevel) {
+		return nil, fmt.Errorf("invalid enforcement level '%s', must be one of: %s", f.EnforcementLevel, strings.Join(RunTaskEnforcementLevels, ", "))
+	}
+	if len(f.Stages) == 0 {
+		f.Stages = []string{"post_plan"}
+	}
+	for _, s := range f.Stages {
+		if !slices.Contains(RunTaskStages, s) {
+			return nil, fmt.Errorf("invalid stage '%s', must be one of: %s", s, strings.Join(RunTaskStages, ", "))
+		}
+	}
+	return f, nil
+}
+
+// ParseRunTaskDetachFlags creates a RunTaskDetachFlags from the current command context
+func ParseRunTaskDetachFlags(cmd *cobra.Command) (*RunTaskDetachFlags, error) {
+	return &RunTaskDetachFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		TaskName:               viper.GetString("task-name"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseRunTaskAttachFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		set        map[string]interface{}
+		wantLevel  string
+		wantStages []string
+		wantErr    bool
+	}{
+		{"defaults", nil, "advisory", []string{"post_plan"}, false},
+		{"mandatory pre and post plan", map[string]interface{}{"enforcement-level": "mandatory", "stages": []string{"pre_plan", "post_plan"}}, "mandatory", []string{"pre_plan", "post_plan"}, false},
+		{"unknown enforcement level", map[string]interface{}{"enforcement-level": "hard-mandatory"}, "", nil, true},
+		{"unknown stage", map[string]interface{}{"stages": []string{"post_apply", "plan"}}, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			viper.Set("task-name", "snyk")
+			viper.Set("project-name", "platform")
+			for k, v := range tt.set {
+				viper.Set(k, v)
+			}
+			got, err := ParseRunTaskAttachFlags(nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRunTaskAttachFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.EnforcementLevel != tt.wantLevel {
+				t.Errorf("EnforcementLevel = %q, want %q", got.EnforcementLevel, tt.wantLevel)
+			}
+			if !reflect.DeepEqual(got.Stages, tt.wantStages) {
+				t.Errorf("Stages = %v, want %v", got.Stages, tt.wantStages)
+			}
+			if got.TaskName != "snyk" || got.ProjectName != "platform" {
+				t.Errorf("ParseRunTaskAttachFlags() = %+v", *got)
+			}
+		})
+	}
+}
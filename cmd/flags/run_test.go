@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseRunCreateFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "app-prod")
+	viper.Set("message", "nightly")
+	viper.Set("wait", true)
+	viper.Set("timeout", "30m")
+
+	got, err := ParseRunCreateFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseRunCreateFlags() error = %v", err)
+	}
+	want := &RunCreateFlags{
+		WorkspaceName: "app-prod",
+		Message:       "nightly",
+		Wait:          true,
+		Timeout:       30 * time.Minute,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseRunCreateFlags() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseRunWatchFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "run-CZcmD7eagjhyX0vN")
+
+	got, err := ParseRunWatchFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseRunWatchFlags() error = %v", err)
+	}
+	want := &RunWatchFlags{ID: "run-CZcmD7eagjhyX0vN"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseRunWatchFlags() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseRunPolicyFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "run-CZcmD7eagjhyX0vN")
+	viper.Set("format", "junit")
+
+	got, err := ParseRunPolicyFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseRunPolicyFlags() error = %v", err)
+	}
+	// Reports always include the policy output
+	want := &RunPolicyFlags{ID: "run-CZcmD7eagjhyX0vN", Logs: true, Format: "junit"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseRunPolicyFlags() = %+v, want %+v", got, want)
+	}
+
+	viper.Set("format", "xml")
+	if _, err := ParseRunPolicyFlags(nil); err == nil {
+		t.Error("ParseRunPolicyFlags() expected error for invalid format")
+	}
+}
+
+func TestParseRunListFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		wantErr bool
+	}{
+		{name: "workspace", values: map[string]interface{}{"name": "app-prod"}},
+		{name: "needs confirmation across organization", values: map[string]interface{}{"needs-confirmation": true, "interactive": true}},
+		{name: "missing name", values: map[string]interface{}{}, wantErr: true},
+		{name: "interactive without needs confirmation", values: map[string]interface{}{"name": "app-prod", "interactive": true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			for k, v := range tt.values {
+				viper.Set(k, v)
+			}
+			_, err := ParseRunListFlags(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRunListFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRunCommentFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "run-CZcmD7eagjhyX0vN")
+	viper.Set("body", "  ")
+	if _, err := ParseRunCommentFlags(nil); err == nil {
+		t.Errorf("ParseRunCommentFlags() expected error for empty body")
+	}
+}
+
+func TestParseRunPolicyOverrideFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("id", "run-CZcmD7eagjhyX0vN")
+	viper.Set("comment", "")
+	if _, err := ParseRunPolicyOverrideFlags(nil); err == nil {
+		t.Errorf("ParseRunPolicyOverrideFlags() expected error for empty comment")
+	}
+}
+
+func TestParseOrgRunListFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("project-name", "platform")
+	viper.Set("status", []string{"applied", "errored"})
+	viper.Set("operation", []string{"plan_only", "destroy"})
+	viper.Set("since", "2025-06-01T00:00:00Z")
+	viper.Set("max-items", 0)
+
+	got, err := ParseOrgRunListFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseOrgRunListFlags() error = %v", err)
+	}
+	if got.ProjectName != "platform" {
+		t.Errorf("ProjectName = %q, want platform", got.ProjectName)
+	}
+	if !reflect.DeepEqual(got.Statuses, []string{"applied", "errored"}) {
+		t.Errorf("Statuses = %v", got.Statuses)
+	}
+	if !reflect.DeepEqual(got.Operations, []string{"plan-only", "destroy"}) {
+		t.Errorf("Operations = %v, want hyphenated names", got.Operations)
+	}
+	if want := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC); !got.Since.Equal(want) {
+		t.Errorf("Since = %v, want %v", got.Since, want)
+	}
+	if !got.Until.IsZero() {
+		t.Errorf("Until = %v, want zero", got.Until)
+	}
+
+	invalid := map[string]map[string]interface{}{
+		"unknown source":     {"source": []string{"vcs"}},
+		"unknown operation":  {"operation": []string{"apply"}},
+		"bad since":          {"since": "yesterday"},
+		"until before since": {"since": "1h", "until": "2h"},
+		"negative max items": {"max-items": -1},
+	}
+	for name, values := range invalid {
+		t.Run(name, func(t *testing.T) {
+			viper.Reset()
+			for k, v := range values {
+				viper.Set(k, v)
+			}
+			if _, err := ParseOrgRunListFlags(nil); err == nil {
+				t.Error("ParseOrgRunListFlags() expected error")
+			}
+		})
+	}
+}
+
+func TestParseRunTime(t *testing.T) {
+	now := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"":                     {},
+		"90m":                  now.Add(-90 * time.Minute),
+		"7d":                   now.AddDate(0, 0, -7),
+		"2025-06-01":           time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		"2025-06-01T08:30:00Z": time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC),
+	}
+	for value, want := range tests {
+		got, err := parseRunTime(value, now)
+		if err != nil {
+			t.Errorf("parseRunTime(%q) error = %v", value, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseRunTime(%q) = %v, want %v", value, got, want)
+		}
+	}
+
+	for _, value := range []string{"soon", "-1h", "-2d"} {
+		if _, err := parseRunTime(value, now); err == nil {
+			t.Errorf("parseRunTime(%q) expected error", value)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// RunTriggerListFlags holds all flags for the run-trigger list command
+type RunTriggerListFlags struct {
+	WorkspaceName string
+	Direction     string
+}
+
+// RunTriggerChangeFlags holds all flags for the run-trigger add and remove commands
+type RunTriggerChangeFlags struct {
+	WorkspaceName string
+	SourceNames   []string
+}
+
+// GraphFlags holds all flags for the graph command
+type GraphFlags struct {
+	WorkspaceSelectorFlags
+	Format      string
+	OutputFile  string
+	Focus       string
+	Parallelism int
+}
+
+// GraphFormats are the supported graph output formats
+var GraphFormats = []string{"dot", "mermaid", "json"}
+
+// ParseRunTriggerListFlags creates a RunTriggerListFlags from the current command context
+func ParseRunTriggerListFlags(cmd *cobra.Command) (*RunTriggerListFlags, error) {
+	f := &RunTriggerListFlags{
+		WorkspaceName: viper.GetString("name"),
+		Direction:     viper.GetString("direction"),
+	}
+	if f.Direction == "" {
+		f.Direction = "inbound"
+	}
+	if f.Direction != "inbound" && f.Direction != "outbound" {
+		return nil, fmt.Errorf("invalid --direction '%s', must be one of: inbound, outbound", f.Direction)
+	}
+	return f, nil
+}
+
+// ParseRunTriggerChangeFlags creates a RunTriggerChangeFlags from the current command context
+func ParseRunTriggerChangeFlags(cmd *cobra.Command) (*RunTriggerChangeFlags, error) {
+	return &RunTriggerChangeFlags{
+		WorkspaceName: viper.GetString("name"),
+		SourceNames:   viper.GetStringSlice("source-name"),
+	}, nil
+}
+
+// ParseGraphFlags creates a GraphFlags from the current command context
+func ParseGraphFlags(cmd *cobra.Command) (*GraphFlags, error) {
+	f := &GraphFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Format:                 viper.GetString("format"),
+		OutputFile:             viper.GetString("output-file"),
+		Focus:                  viper.GetString("focus"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+	if f.Format == "" {
+		f.Format = "dot"
+	}
+	if !slices.Contains(GraphFormats, f.Format) {
+		return nil, fmt.Errorf("invalid --format '%s', must be one of: %s", f.Format, strings.Join(GraphFormats, ", "))
+	}
+	return f, nil
+}
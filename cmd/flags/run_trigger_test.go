@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseRunTriggerListFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "app-prod")
+
+	got, err := ParseRunTriggerListFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseRunTriggerListFlags() error = %v", err)
+	}
+	if got.Direction != "inbound" {
+		t.Errorf("Direction = %q, want inbound", got.Direction)
+	}
+
+	viper.Set("direction", "sideways")
+	if _, err := ParseRunTriggerListFlags(nil); err == nil {
+		t.Errorf("ParseRunTriggerListFlags() expected error for invalid direction")
+	}
+}
+
+func TestParseGraphFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("format", "mermaid")
+	viper.Set("focus", "network-prod")
+
+	got, err := ParseGraphFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseGraphFlags() error = %v", err)
+	}
+	if got.Format != "mermaid" || got.Focus != "network-prod" {
+		t.Errorf("ParseGraphFlags() = %+v", *got)
+	}
+
+	viper.Set("format", "svg")
+	if _, err := ParseGraphFlags(nil); err == nil {
+		t.Errorf("ParseGraphFlags() expected error for invalid format")
+	}
+}
@@ -4,6 +4,11 @@
 package flags
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -19,3 +24,86 @@ func ParseTeamListFlags(cmd *cobra.Command) (*TeamListFlags, error) {
 		WorkspaceName: viper.GetString("name"),
 	}, nil
 }
+
+// TeamAccessFlags holds all flags for the team add and update commands.
+// WorkspaceLocking and RunTasks are nil when the flag was not supplied.
+type TeamAccessFlags struct {
+	WorkspaceSelectorFlags
+	TeamName         string
+	Access           string
+	Runs             string
+	Variables        string
+	StateVersions    string
+	SentinelMocks    string
+	WorkspaceLocking *bool
+	RunTasks         *bool
+	Parallelism      int
+}
+
+// TeamRemoveFlags holds all flags for the team remove command
+type TeamRemoveFlags struct {
+	WorkspaceSelectorFlags
+	TeamName    string
+	Parallelism int
+}
+
+var (
+	teamAccessLevels         = []string{"read", "plan", "write", "admin", "custom"}
+	teamRunsPermissions      = []string{"read", "plan", "apply"}
+	teamVariablesPermissions = []string{"none", "read", "write"}
+	teamStatePermissions     = []string{"none", "read-outputs", "read", "write"}
+	teamMocksPermissions     = []string{"none", "read"}
+)
+
+// ParseTeamAccessFlags creates a TeamAccessFlags from the current command context
+func ParseTeamAccessFlags(cmd *cobra.Command) (*TeamAccessFlags, error) {
+	f := &TeamAccessFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		TeamName:               viper.GetString("team-name"),
+		Access:                 viper.GetString("access"),
+		Runs:                   viper.GetString("runs"),
+		Variables:              viper.GetString("variables"),
+		StateVersions:          viper.GetString("state-versions"),
+		SentinelMocks:          viper.GetString("sentinel-mocks"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}
+	if viper.IsSet("workspace-locking") {
+		f.WorkspaceLocking = boolPtr(viper.GetBool("workspace-locking"))
+	}
+	if viper.IsSet("run-tasks") {
+		f.RunTasks = boolPtr(viper.GetBool("run-tasks"))
+	}
+
+	checks := []struct {
+		flag    string
+		value   string
+		allowed []string
+	}{
+		{"access", f.Access, teamAccessLevels},
+		{"runs", f.Runs, teamRunsPermissions},
+		{"variables", f.Variables, teamVariablesPermissions},
+		{"state-versions", f.StateVersions, teamStatePermissions},
+		{"sentinel-mocks", f.SentinelMocks, teamMocksPermissions},
+	}
+	for _, c := range checks {
+		if c.value != "" && !slices.Contains(c.allowed, c.value) {
+			return nil, fmt.Errorf("invalid --%s '%s', must be one of: %s", c.flag, c.value, strings.Join(c.allowed, ", "))
+		}
+	}
+
+	hasPermissions := f.Runs != "" || f.Variables != "" || f.StateVersions != "" || f.SentinelMocks != "" || f.WorkspaceLocking != nil || f.RunTasks != nil
+	if hasPermissions && f.Access != "custom" {
+		return nil, errors.New("permission flags can only be used with --access custom")
+	}
+
+	return f, nil
+}
+
+// ParseTeamRemoveFlags creates a TeamRemoveFlags from the current command context
+func ParseTeamRemoveFlags(cmd *cobra.Command) (*TeamRemoveFlags, error) {
+	return &TeamRemoveFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		TeamName:               viper.GetString("team-name"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
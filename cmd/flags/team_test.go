@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseTeamAccessFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("tags", "team:app")
+	viper.Set("team-name", "app-team")
+	viper.Set("access", "custom")
+	viper.Set("runs", "plan")
+	viper.Set("state-versions", "read-outputs")
+	viper.Set("workspace-locking", true)
+	viper.Set("parallelism", 3)
+
+	got, err := ParseTeamAccessFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseTeamAccessFlags() error = %v", err)
+	}
+	want := TeamAccessFlags{
+		WorkspaceSelectorFlags: WorkspaceSelectorFlags{Tags: "team:app"},
+		TeamName:               "app-team",
+		Access:                 "custom",
+		Runs:                   "plan",
+		StateVersions:          "read-outputs",
+		WorkspaceLocking:       boolPtr(true),
+		Parallelism:            3,
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseTeamAccessFlags() = %+v, want %+v", *got, want)
+	}
+	// Permissions that were not supplied are left unchanged by update
+	if got.RunTasks != nil {
+		t.Errorf("RunTasks = %v, want nil when --run-tasks is not set", *got.RunTasks)
+	}
+}
+
+func TestParseTeamAccessFlagsValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		set  map[string]interface{}
+	}{
+		{"invalid access", map[string]interface{}{"access": "owner"}},
+		{"invalid runs", map[string]interface{}{"access": "custom", "runs": "destroy"}},
+		{"permission without custom", map[string]interface{}{"access": "write", "variables": "read"}},
+		{"locking without custom", map[string]interface{}{"access": "read", "workspace-locking": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			viper.Set("team-name", "app-team")
+			for k, v := range tt.set {
+				viper.Set(k, v)
+			}
+			if _, err := ParseTeamAccessFlags(nil); err == nil {
+				t.Errorf("ParseTeamAccessFlags() expected error")
+			}
+		})
+	}
+}
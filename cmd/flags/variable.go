@@ -4,6 +4,8 @@
 package flags
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -49,6 +51,16 @@ type VariableDeleteFlags struct {
 	Key           string
 }
 
+// VariableSyncFlags holds all flags for the variable sync command
+type VariableSyncFlags struct {
+	WorkspaceName string
+	File          string
+	EnvFile       string
+	Prune         bool
+	DryRun        bool
+	AutoApprove   bool
+}
+
 // ParseVariableListFlags creates a VariableListFlags from the current command context
 func ParseVariableListFlags(cmd *cobra.Command) (*VariableListFlags, error) {
 	return &VariableListFlags{
@@ -99,3 +111,19 @@ func ParseVariableDeleteFlags(cmd *cobra.Command) (*VariableDeleteFlags, error)
 		Key:           viper.GetString("key"),
 	}, nil
 }
+
+// ParseVariableSyncFlags creates a VariableSyncFlags from the current command context
+func ParseVariableSyncFlags(cmd *cobra.Command) (*VariableSyncFlags, error) {
+	f := &VariableSyncFlags{
+		WorkspaceName: viper.GetString("name"),
+		File:          viper.GetString("file"),
+		EnvFile:       viper.GetString("env-file"),
+		Prune:         viper.GetBool("prune"),
+		DryRun:        viper.GetBool("dry-run"),
+		AutoApprove:   viper.GetBool("auto-approve"),
+	}
+	if f.File == "" && f.EnvFile == "" {
+		return nil, errors.New("at least one of --file or --env-file is required")
+	}
+	return f, nil
+}
@@ -236,3 +236,26 @@ func TestParseVariableDeleteFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVariableSyncFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "my-workspace")
+	viper.Set("env-file", ".env")
+	viper.Set("prune", true)
+	viper.Set("auto-approve", true)
+
+	got, err := ParseVariableSyncFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseVariableSyncFlags() error = %v", err)
+	}
+	want := VariableSyncFlags{WorkspaceName: "my-workspace", EnvFile: ".env", Prune: true, AutoApprove: true}
+	if *got != want {
+		t.Errorf("ParseVariableSyncFlags() = %+v, want %+v", *got, want)
+	}
+
+	viper.Reset()
+	viper.Set("name", "my-workspace")
+	if _, err := ParseVariableSyncFlags(nil); err == nil {
+		t.Errorf("ParseVariableSyncFlags() without a file expected an error")
+	}
+}
@@ -43,3 +43,26 @@ func ParseWorkspaceShowFlags(cmd *cobra.Command) (*WorkspaceShowFlags, error) {
 		Name: viper.GetString("name"),
 	}, nil
 }
+
+// WorkspaceSelectorFlags holds the shared flags used by bulk commands to select workspaces
+type WorkspaceSelectorFlags struct {
+	Names        []string
+	Search       string
+	WildcardName string
+	Tags         string
+	ExcludeTags  string
+	ProjectName  string
+}
+
+// parseWorkspaceSelectorFlags reads the workspace selector flags, projectKey names the
+// flag holding the project filter since some commands already use --project-name
+func parseWorkspaceSelectorFlags(projectKey string) WorkspaceSelectorFlags {
+	return WorkspaceSelectorFlags{
+		Names:        viper.GetStringSlice("name"),
+		Search:       viper.GetString("search"),
+		WildcardName: viper.GetString("wildcard-name"),
+		Tags:         viper.GetString("tags"),
+		ExcludeTags:  viper.GetString("exclude-tags"),
+		ProjectName:  viper.GetString(projectKey),
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// WorkspaceMoveFlags holds all flags for the workspace move command
+type WorkspaceMoveFlags struct {
+	WorkspaceSelectorFlags
+	ProjectName  string
+	FromFile     string
+	RollbackFile string
+	DryRun       bool
+	Parallelism  int
+}
+
+// ParseWorkspaceMoveFlags creates a WorkspaceMoveFlags from the current command context
+func ParseWorkspaceMoveFlags(cmd *cobra.Command) (*WorkspaceMoveFlags, error) {
+	return &WorkspaceMoveFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("source-project-name"),
+		ProjectName:            viper.GetString("project-name"),
+		FromFile:               viper.GetString("from-file"),
+		RollbackFile:           viper.GetString("rollback-file"),
+		DryRun:                 viper.GetBool("dry-run"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseWorkspaceMoveFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func()
+		want  WorkspaceMoveFlags
+	}{
+		{
+			name:  "defaults when nothing set",
+			setup: func() {},
+			want:  WorkspaceMoveFlags{},
+		},
+		{
+			name: "selector and target set",
+			setup: func() {
+				viper.Set("project-name", "platform")
+				viper.Set("name", []string{"app-dev", "app-prod"})
+				viper.Set("tags", "team:platform")
+				viper.Set("source-project-name", "Default")
+				viper.Set("parallelism", 10)
+			},
+			want: WorkspaceMoveFlags{
+				WorkspaceSelectorFlags: WorkspaceSelectorFlags{
+					Names:       []string{"app-dev", "app-prod"},
+					Tags:        "team:platform",
+					ProjectName: "Default",
+				},
+				ProjectName: "platform",
+				Parallelism: 10,
+			},
+		},
+		{
+			name: "from file with dry run",
+			setup: func() {
+				viper.Set("from-file", "rollback.json")
+				viper.Set("dry-run", true)
+			},
+			want: WorkspaceMoveFlags{
+				FromFile: "rollback.json",
+				DryRun:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			tt.setup()
+
+			got, err := ParseWorkspaceMoveFlags(nil)
+			if err != nil {
+				t.Fatalf("ParseWorkspaceMoveFlags() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ParseWorkspaceMoveFlags() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
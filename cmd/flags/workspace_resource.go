@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ResourceListFlags holds all flags for the workspace resource list command
+type ResourceListFlags struct {
+	WorkspaceName string
+	Types         []string
+	Module        string
+	Parallelism   int
+}
+
+// ResourceCountFlags holds all flags for the workspace resource count command
+type ResourceCountFlags struct {
+	WorkspaceSelectorFlags
+	Billable    bool
+	Parallelism int
+}
+
+// ParseResourceListFlags creates a ResourceListFlags from the current command context
+func ParseResourceListFlags(cmd *cobra.Command) (*ResourceListFlags, error) {
+	return &ResourceListFlags{
+		WorkspaceName: viper.GetString("name"),
+		Types:         viper.GetStringSlice("type"),
+		Module:        viper.GetString("module"),
+		Parallelism:   viper.GetInt("parallelism"),
+	}, nil
+}
+
+// ParseResourceCountFlags creates a ResourceCountFlags from the current command context
+func ParseResourceCountFlags(cmd *cobra.Command) (*ResourceCountFlags, error) {
+	return &ResourceCountFlags{
+		WorkspaceSelectorFlags: parseWorkspaceSelectorFlags("project-name"),
+		Billable:               viper.GetBool("billable"),
+		Parallelism:            viper.GetInt("parallelism"),
+	}, nil
+}
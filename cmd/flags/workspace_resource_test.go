@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package flags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestParseResourceListFlags(t *testing.T) {
+	viper.Reset()
+	viper.Set("name", "app-prod")
+	viper.Set("type", []string{"aws_instance", "aws_s3_bucket"})
+	viper.Set("module", "module.network")
+	viper.Set("parallelism", 3)
+
+	got, err := ParseResourceListFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseResourceListFlags() error = %v", err)
+	}
+	want := &ResourceListFlags{
+		WorkspaceName: "app-prod",
+		Types:         []string{"aws_instance", "aws_s3_bucket"},
+		Module:        "module.network",
+		Parallelism:   3,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseResourceListFlags() = %+v, want %+v", got, want)
+	}
+}
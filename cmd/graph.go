@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+	"github.com/straubt1/tfx/pkg/depgraph"
+)
+
+var (
+	// `tfx graph` command
+	graphCmd = &cobra.Command{
+		Use:   "graph",
+		Short: "Workspace dependency graph",
+		Long: `Build a dependency graph of Workspaces from run triggers and remote state consumers.
+Edges point from the Workspace that changes to the Workspace it affects, so everything downstream
+of a Workspace is the blast radius of changing it. Workspaces sharing state globally are highlighted
+since any Workspace in the Organization may read their state.`,
+		Example: `
+Render the whole organization with Graphviz:
+tfx graph --format dot --output-file graph.dot && dot -Tsvg graph.dot > graph.svg
+
+Show the blast radius of a shared workspace as Mermaid:
+tfx graph --focus network-prod --format mermaid --output-file network-prod.mmd
+
+Export a project as JSON:
+tfx graph --project-name platform --format json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseGraphFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return graph(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx graph`
+	addWorkspaceSelectorFlags(graphCmd, "project-name")
+	graphCmd.Flags().String("format", "dot", fmt.Sprintf("Output format (optional, one of: %s).", strings.Join(flags.GraphFormats, ", ")))
+	graphCmd.Flags().String("output-file", "", "Write the graph to this file instead of stdout (optional).")
+	graphCmd.Flags().String("focus", "", "Only include this Workspace and everything downstream of it (optional).")
+	graphCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	rootCmd.AddCommand(graphCmd)
+}
+
+func graph(cmdConfig *flags.GraphFlags) error {
+	v := view.NewGraphView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// The graph itself goes to stdout, so only print a header when writing to a file
+	if cmdConfig.OutputFile != "" {
+		v.PrintCommandHeader("Building dependency graph for organization '%s'", c.OrganizationName)
+		printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	g, err := data.FetchDependencyGraph(c, workspaces, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to build dependency graph"))
+	}
+
+	if cmdConfig.Focus != "" {
+		focusID := ""
+		for _, n := range g.Nodes {
+			if n.Name == cmdConfig.Focus {
+				focusID = n.ID
+			}
+		}
+		if focusID == "" {
+			return v.RenderError(fmt.Errorf("workspace '%s' is not in the graph", cmdConfig.Focus))
+		}
+		g = g.Downstream(focusID)
+	}
+
+	rendered, err := renderGraph(g, cmdConfig.Format)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	if cmdConfig.OutputFile == "" {
+		return v.Render(g, rendered)
+	}
+	if err := os.WriteFile(cmdConfig.OutputFile, []byte(rendered), 0644); err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to write graph"))
+	}
+	return v.RenderFile(g, cmdConfig.Format, cmdConfig.OutputFile)
+}
+
+func renderGraph(g *depgraph.Graph, format string) (string, error) {
+	switch format {
+	case "mermaid":
+		return g.Mermaid(), nil
+	case "json":
+		b, err := json.MarshalIndent(g, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(b) + "\n", nil
+	default:
+		return g.DOT(), nil
+	}
+}
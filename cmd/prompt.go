@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var promptReader = bufio.NewReader(os.Stdin)
+
+// prompt asks a question on the terminal and returns the trimmed, lower case answer. An empty
+// answer is returned when input ends.
+func prompt(question string) (string, error) {
+	fmt.Print(question + " ")
+	answer, err := promptReader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.ToLower(strings.TrimSpace(answer)), nil
+}
+
+// isYes returns true for answers that confirm a prompt
+func isYes(answer string) bool {
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// `tfx report` commands
+	reportCmd = &cobra.Command{
+		Use:   "report",
+		Short: "Report Commands",
+		Long:  "Organization wide reports across Workspaces, suitable for scheduled CI jobs.",
+		Example: `
+Report drift for every workspace in a project:
+tfx report drift --project-name platform
+
+Write a CSV of drifted resources and failed checks:
+tfx report drift --format csv --output-file drift.csv
+
+Find workspaces with no activity in the last 180 days:
+tfx report stale --days 180
+
+Total the monthly cost of each project:
+tfx report cost --project`,
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
+
+// writeReportFile writes a report to outputFile, as CSV using writeCSV when the format is csv
+// and as indented JSON otherwise
+func writeReportFile(outputFile string, format string, report interface{}, writeCSV func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if format == "csv" {
+		if err := writeCSV(&buf); err != nil {
+			return err
+		}
+	} else {
+		encoder := json.NewEncoder(&buf)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(outputFile, buf.Bytes(), 0644)
+}
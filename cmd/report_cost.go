@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx report cost` command
+	reportCostCmd = &cobra.Command{
+		Use:   "cost",
+		Short: "Report monthly cost",
+		Long: `Report the estimated monthly cost of every selected Workspace, from the cost estimate of its
+latest applied run. Use --project to total the cost per Project instead. Workspaces without a
+cost estimate are listed so gaps in coverage are visible, but add nothing to the totals.`,
+		Example: `
+Report the cost of every workspace:
+tfx report cost
+
+Write a CSV of the monthly cost per project:
+tfx report cost --project --format csv --output-file cost.csv
+
+Total cost of the workspaces in a project:
+tfx report cost --project-name platform --json | jq '[.[].monthlyCost] | add'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseCostReportFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return reportCost(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx report cost`
+	addWorkspaceSelectorFlags(reportCostCmd, "project-name")
+	reportCostCmd.Flags().Bool("project", false, "Report the total monthly cost per Project (optional).")
+	reportCostCmd.Flags().String("format", "table", fmt.Sprintf("Output format (optional, one of: %s).", strings.Join(flags.ReportFormats, ", ")))
+	reportCostCmd.Flags().String("output-file", "", "Write the report to this file, requires --format csv or json (optional).")
+	reportCostCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	reportCmd.AddCommand(reportCostCmd)
+}
+
+func reportCost(cmdConfig *flags.CostReportFlags) error {
+	v := view.NewCostReportView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// CSV and JSON go to stdout, so only print a header for the table or when writing to a file
+	if cmdConfig.Format == "table" || cmdConfig.OutputFile != "" {
+		v.PrintCommandHeader("Reporting monthly cost for organization '%s'", c.OrganizationName)
+		printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	costs, err := data.FetchCostReport(c, c.OrganizationName, workspaces, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to build cost report"))
+	}
+	sort.SliceStable(costs, func(i, j int) bool {
+		return costs[i].MonthlyCost > costs[j].MonthlyCost
+	})
+
+	if cmdConfig.Project {
+		return renderProjectCostReport(v, cmdConfig, costs)
+	}
+
+	writeCSV := func(w io.Writer) error { return view.WriteCostReportCSV(w, costs) }
+	if cmdConfig.OutputFile != "" {
+		if err := writeReportFile(cmdConfig.OutputFile, cmdConfig.Format, costs, writeCSV); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to write report"))
+		}
+		return v.RenderFile(costs, cmdConfig.OutputFile)
+	}
+
+	switch {
+	case cmdConfig.Format == "csv" && !v.IsJSON():
+		return v.RenderCSV(writeCSV)
+	case cmdConfig.Format == "json":
+		return v.Output().RenderJSON(costs)
+	default:
+		return v.Render(costs)
+	}
+}
+
+func renderProjectCostReport(v *view.CostReportView, cmdConfig *flags.CostReportFlags, costs []view.WorkspaceCost) error {
+	projects := view.CostByProject(costs)
+
+	writeCSV := func(w io.Writer) error { return view.WriteProjectCostReportCSV(w, projects) }
+	if cmdConfig.OutputFile != "" {
+		if err := writeReportFile(cmdConfig.OutputFile, cmdConfig.Format, projects, writeCSV); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to write report"))
+		}
+		return v.RenderFile(costs, cmdConfig.OutputFile)
+	}
+
+	switch {
+	case cmdConfig.Format == "csv" && !v.IsJSON():
+		return v.RenderCSV(writeCSV)
+	case cmdConfig.Format == "json":
+		return v.Output().RenderJSON(projects)
+	default:
+		return v.RenderProjects(projects)
+	}
+}
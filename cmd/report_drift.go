@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx report drift` command
+	reportDriftCmd = &cobra.Command{
+		Use:   "drift",
+		Short: "Report drift and failed checks",
+		Long: `Report the current health assessment of every selected Workspace, listing the resources that
+drifted and the continuous validation checks that failed. Workspaces without assessments enabled
+are included with a status of "Not enabled".`,
+		Example: `
+Report drift for production workspaces in a project:
+tfx report drift --project-name platform --tags prod
+
+Nightly CI job writing a CSV and failing when anything drifted:
+tfx report drift --only-drifted --format csv --output-file drift.csv --fail-on-drift
+
+Pipe the report to jq:
+tfx report drift --json | jq '.[] | select(.drifted)'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseDriftReportFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return reportDrift(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx report drift`
+	addWorkspaceSelectorFlags(reportDriftCmd, "project-name")
+	reportDriftCmd.Flags().String("format", "table", fmt.Sprintf("Output format (optional, one of: %s).", strings.Join(flags.ReportFormats, ", ")))
+	reportDriftCmd.Flags().String("output-file", "", "Write the report to this file, requires --format csv or json (optional).")
+	reportDriftCmd.Flags().Bool("only-drifted", false, "Only report Workspaces that drifted, have failing checks or failed to assess (optional).")
+	reportDriftCmd.Flags().Bool("summary", false, "Only report counts, skip reading the drifted resources and failed checks (optional).")
+	reportDriftCmd.Flags().Bool("fail-on-drift", false, "Exit with a non-zero code when any Workspace drifted or has failing checks (optional).")
+	reportDriftCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	reportCmd.AddCommand(reportDriftCmd)
+}
+
+func reportDrift(cmdConfig *flags.DriftReportFlags) error {
+	v := view.NewDriftReportView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// CSV and JSON go to stdout, so only print a header for the table or when writing to a file
+	if cmdConfig.Format == "table" || cmdConfig.OutputFile != "" {
+		v.PrintCommandHeader("Reporting drift for organization '%s'", c.OrganizationName)
+		printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	assessments, err := data.FetchDriftReport(c, c.OrganizationName, workspaces, !cmdConfig.Summary, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to build drift report"))
+	}
+
+	findings := 0
+	reported := make([]view.WorkspaceAssessment, 0, len(assessments))
+	for _, a := range assessments {
+		if a.HasFindings() {
+			findings++
+		} else if cmdConfig.OnlyFindings {
+			continue
+		}
+		reported = append(reported, a)
+	}
+
+	if err := renderDriftReport(v, cmdConfig, reported); err != nil {
+		return err
+	}
+	if cmdConfig.FailOnDrift && findings > 0 {
+		return fmt.Errorf("%d workspace(s) have drift or failing checks", findings)
+	}
+	return nil
+}
+
+func renderDriftReport(v *view.DriftReportView, cmdConfig *flags.DriftReportFlags, assessments []view.WorkspaceAssessment) error {
+	if cmdConfig.OutputFile == "" {
+		switch {
+		case cmdConfig.Format == "csv" && !v.IsJSON():
+			return v.RenderCSV(assessments)
+		case cmdConfig.Format == "json":
+			return v.Output().RenderJSON(assessments)
+		default:
+			return v.Render(assessments)
+		}
+	}
+
+	writeCSV := func(w io.Writer) error { return view.WriteDriftReportCSV(w, assessments) }
+	if err := writeReportFile(cmdConfig.OutputFile, cmdConfig.Format, assessments, writeCSV); err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to write report"))
+	}
+	return v.RenderFile(assessments, cmdConfig.OutputFile)
+}
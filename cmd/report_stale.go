@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx report stale` command
+	reportStaleCmd = &cobra.Command{
+		Use:   "stale",
+		Short: "Report stale Workspaces",
+		Long: `Find Workspaces with no runs, state versions or configuration versions within the last --days.
+Each stale Workspace is reported with its project, last activity, resource count and the teams
+with admin access, so the report can feed a cleanup or lock campaign.`,
+		Example: `
+tfx report stale --days 180
+
+Write a CSV of stale workspaces in a project:
+tfx report stale --days 365 --project-name sandbox --format csv --output-file stale.csv
+
+List the names of stale workspaces:
+tfx report stale --days 365 --json | jq -r '.[].workspaceName'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseStaleReportFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return reportStale(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx report stale`
+	addWorkspaceSelectorFlags(reportStaleCmd, "project-name")
+	reportStaleCmd.Flags().Int("days", 180, "Workspaces with no activity in this many days are stale (optional).")
+	reportStaleCmd.Flags().String("format", "table", fmt.Sprintf("Output format (optional, one of: %s).", strings.Join(flags.ReportFormats, ", ")))
+	reportStaleCmd.Flags().String("output-file", "", "Write the report to this file, requires --format csv or json (optional).")
+	reportStaleCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	reportCmd.AddCommand(reportStaleCmd)
+}
+
+func reportStale(cmdConfig *flags.StaleReportFlags) error {
+	v := view.NewStaleReportView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -cmdConfig.Days)
+
+	// CSV and JSON go to stdout, so only print a header for the table or when writing to a file
+	if cmdConfig.Format == "table" || cmdConfig.OutputFile != "" {
+		v.PrintCommandHeader("Finding workspaces with no activity in %d days in organization '%s'", cmdConfig.Days, c.OrganizationName)
+		printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	stale, err := data.FetchStaleWorkspaces(c, c.OrganizationName, workspaces, cutoff, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to build stale report"))
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].DaysInactive > stale[j].DaysInactive
+	})
+
+	if cmdConfig.OutputFile != "" {
+		writeCSV := func(w io.Writer) error { return view.WriteStaleReportCSV(w, stale) }
+		if err := writeReportFile(cmdConfig.OutputFile, cmdConfig.Format, stale, writeCSV); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to write report"))
+		}
+		return v.RenderFile(stale, len(workspaces), cmdConfig.OutputFile)
+	}
+
+	switch {
+	case cmdConfig.Format == "csv" && !v.IsJSON():
+		return v.RenderCSV(stale)
+	case cmdConfig.Format == "json":
+		return v.Output().RenderJSON(stale)
+	default:
+		return v.Render(stale, len(workspaces))
+	}
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -85,6 +86,10 @@ func Execute() {
 	// Always close output system for clean shutdown
 	output.Get().Close()
 
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.code)
+	}
 	if err != nil {
 		log.Fatal(aurora.Red(err))
 	}
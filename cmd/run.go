@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx run` commands
+	orgRunCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run Commands",
+		Long:  "Work with Runs across the Workspaces of an Organization.",
+	}
+
+	// `tfx run list` command
+	orgRunListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List Runs",
+		Long: `List Runs across the Organization, or the Workspaces matched by the selector flags, newest first.
+Runs can be filtered by status, source, operation, the user that triggered them and when they were
+created. --since and --until compare against the time a Run was created, not when it was applied
+or finished, and take a duration before now such as 90m, 1h or 7d, a date or an RFC3339 timestamp.`,
+		Example: `
+Runs created in prod in the last hour that have applied:
+tfx run list --wildcard-name "*-prod" --status applied --since 1h
+
+List destroy runs in a project:
+tfx run list --project-name platform --operation destroy
+
+List the runs a user triggered from the UI in the last week:
+tfx run list --triggered-by jdoe --source tfe-ui --since 7d --max-items 0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseOrgRunListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return orgRunList(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx run list`
+	addWorkspaceSelectorFlags(orgRunListCmd, "project-name")
+	orgRunListCmd.Flags().StringSlice("status", []string{}, "Run status, can be supplied multiple times or comma separated, Examples: applied, errored (optional).")
+	orgRunListCmd.Flags().StringSlice("source", []string{}, fmt.Sprintf("Run source, can be supplied multiple times or comma separated (optional, one of: %s).", strings.Join(flags.RunSources, ", ")))
+	orgRunListCmd.Flags().StringSlice("operation", []string{}, fmt.Sprintf("Run operation, can be supplied multiple times or comma separated (optional, one of: %s).", strings.Join(flags.RunOperations, ", ")))
+	orgRunListCmd.Flags().String("triggered-by", "", "Username of the user that triggered the Run (optional).")
+	orgRunListCmd.Flags().String("since", "", "Only Runs created after this time, Examples: 1h, 7d, 2025-06-01 (optional).")
+	orgRunListCmd.Flags().String("until", "", "Only Runs created before this time, Examples: 30m, 2025-06-02T12:00:00Z (optional).")
+	orgRunListCmd.Flags().IntP("max-items", "m", 100, "Max number of results, 0 for all (optional).")
+	orgRunListCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently when the Organization run listing is unavailable (optional).")
+
+	rootCmd.AddCommand(orgRunCmd)
+	orgRunCmd.AddCommand(orgRunListCmd)
+}
+
+func orgRunList(cmdConfig *flags.OrgRunListFlags) error {
+	v := view.NewOrgRunListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing runs in organization '%s'", c.OrganizationName)
+	printFilterList(v.BaseView, append(selectorFilters(cmdConfig.WorkspaceSelectorFlags, ""), runFilters(cmdConfig)...))
+
+	// Without a selector the organization listing covers every workspace
+	selector := workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags)
+	var workspaces []*tfe.Workspace
+	if !selector.IsEmpty() {
+		workspaces, err = data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+		}
+		if len(workspaces) == 0 {
+			return v.Render(nil)
+		}
+	}
+
+	filter := data.RunFilter{
+		Statuses:    cmdConfig.Statuses,
+		Sources:     cmdConfig.Sources,
+		Operations:  cmdConfig.Operations,
+		TriggeredBy: cmdConfig.TriggeredBy,
+		Since:       cmdConfig.Since,
+		Until:       cmdConfig.Until,
+	}
+	runs, err := data.FetchOrganizationRuns(c, c.OrganizationName, workspaces, filter, cmdConfig.MaxItems, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list runs"))
+	}
+
+	return v.Render(runs)
+}
+
+// runFilters describes the run filter flags that are set
+func runFilters(f *flags.OrgRunListFlags) []string {
+	var filtersSet []string
+	if len(f.Statuses) > 0 {
+		filtersSet = append(filtersSet, "status: "+strings.Join(f.Statuses, ", "))
+	}
+	if len(f.Sources) > 0 {
+		filtersSet = append(filtersSet, "source: "+strings.Join(f.Sources, ", "))
+	}
+	if len(f.Operations) > 0 {
+		filtersSet = append(filtersSet, "operation: "+strings.Join(f.Operations, ", "))
+	}
+	if f.TriggeredBy != "" {
+		filtersSet = append(filtersSet, "triggered-by: "+f.TriggeredBy)
+	}
+	if !f.Since.IsZero() {
+		filtersSet = append(filtersSet, "since: "+view.FormatDateTime(f.Since))
+	}
+	if !f.Until.IsZero() {
+		filtersSet = append(filtersSet, "until: "+view.FormatDateTime(f.Until))
+	}
+	return filtersSet
+}
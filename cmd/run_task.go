@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx run-task` command
+	runTaskCmd = &cobra.Command{
+		Use:   "run-task",
+		Short: "Run Task Commands",
+		Long:  "Work with Run Tasks of a TFx Organization and attach them to Workspaces.",
+	}
+
+	// `tfx run-task list` command
+	runTaskListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List run tasks",
+		Long:  "List Run Tasks in a TFx Organization with the number of Workspaces each is attached to.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTaskListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runTaskList(cmdConfig)
+		},
+	}
+
+	// `tfx run-task create` command
+	runTaskCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create a run task",
+		Long:  "Create a Run Task in a TFx Organization that sends a payload to an external service, such as Snyk or Infracost.",
+		Example: `
+tfx run-task create --name snyk --url https://api.snyk.io/v1/terraform-cloud/run-task --hmac-key "$SNYK_HMAC_KEY"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTaskCreateFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runTaskCreate(cmdConfig)
+		},
+	}
+
+	// `tfx run-task attach` command
+	runTaskAttachCmd = &cobra.Command{
+		Use:   "attach",
+		Short: "Attach a run task to Workspaces",
+		Long: `Attach a Run Task to every selected Workspace. A Workspace that already has the Run Task is
+updated when its enforcement level or stages differ.`,
+		Example: `
+Run Snyk after every plan of the workspaces in a project:
+tfx run-task attach --task-name snyk --project-name platform
+
+Block applies of production workspaces on a failed check:
+tfx run-task attach --task-name snyk --tags env:prod --enforcement-level mandatory --stages post_plan,pre_apply`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTaskAttachFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runTaskAttach(cmdConfig)
+		},
+	}
+
+	// `tfx run-task detach` command
+	runTaskDetachCmd = &cobra.Command{
+		Use:   "detach",
+		Short: "Detach a run task from Workspaces",
+		Long:  "Remove a Run Task from every selected Workspace. The Run Task itself is not deleted.",
+		Example: `
+tfx run-task detach --task-name snyk --wildcard-name "sandbox-*"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTaskDetachFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runTaskDetach(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx run-task create` flags
+	runTaskCreateCmd.Flags().StringP("name", "n", "", "Name of the Run Task.")
+	runTaskCreateCmd.Flags().String("url", "", "URL the Run Task payload is sent to.")
+	runTaskCreateCmd.Flags().StringP("description", "d", "", "Description of the Run Task (optional).")
+	runTaskCreateCmd.Flags().String("hmac-key", "", "HMAC key used to sign the payload, it is write only (optional).")
+	runTaskCreateCmd.Flags().Bool("enabled", true, "Enable the Run Task (optional).")
+	runTaskCreateCmd.MarkFlagRequired("name")
+	runTaskCreateCmd.MarkFlagRequired("url")
+
+	// `tfx run-task attach` flags
+	addWorkspaceSelectorFlags(runTaskAttachCmd, "project-name")
+	runTaskAttachCmd.Flags().String("task-name", "", "Name of the Run Task.")
+	runTaskAttachCmd.Flags().String("enforcement-level", "advisory", fmt.Sprintf("Enforcement level (optional, one of: %s).", strings.Join(flags.RunTaskEnforcementLevels, ", ")))
+	runTaskAttachCmd.Flags().StringSlice("stages", []string{"post_plan"}, fmt.Sprintf("Stages the Run Task runs in (optional, one or more of: %s).", strings.Join(flags.RunTaskStages, ", ")))
+	runTaskAttachCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to change concurrently (optional).")
+	runTaskAttachCmd.MarkFlagRequired("task-name")
+
+	// `tfx run-task detach` flags
+	addWorkspaceSelectorFlags(runTaskDetachCmd, "project-name")
+	runTaskDetachCmd.Flags().String("task-name", "", "Name of the Run Task.")
+	runTaskDetachCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to change concurrently (optional).")
+	runTaskDetachCmd.MarkFlagRequired("task-name")
+
+	rootCmd.AddCommand(runTaskCmd)
+	runTaskCmd.AddCommand(runTaskListCmd)
+	runTaskCmd.AddCommand(runTaskCreateCmd)
+	runTaskCmd.AddCommand(runTaskAttachCmd)
+	runTaskCmd.AddCommand(runTaskDetachCmd)
+}
+
+func runTaskList(cmdConfig *flags.RunTaskListFlags) error {
+	v := view.NewRunTaskListView()
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing run tasks in organization '%s'", c.OrganizationName)
+
+	tasks, err := data.FetchRunTasks(c, c.OrganizationName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list run tasks"))
+	}
+	return v.Render(tasks)
+}
+
+func runTaskCreate(cmdConfig *flags.RunTaskCreateFlags) error {
+	v := view.NewRunTaskCreateView()
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Creating run task '%s' in organization '%s'", cmdConfig.Name, c.OrganizationName)
+
+	options := tfe.RunTaskCreateOptions{
+		Name:     cmdConfig.Name,
+		URL:      cmdConfig.URL,
+		Category: "task",
+		Enabled:  &cmdConfig.Enabled,
+	}
+	if cmdConfig.Description != "" {
+		options.Description = &cmdConfig.Description
+	}
+	if cmdConfig.HMACKey != "" {
+		options.HMACKey = &cmdConfig.HMACKey
+	}
+
+	task, err := data.CreateRunTask(c, c.OrganizationName, options)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to create run task"))
+	}
+	return v.Render(task)
+}
+
+func runTaskAttach(cmdConfig *flags.RunTaskAttachFlags) error {
+	v := view.NewRunTaskAttachView()
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Attaching run task '%s' in organization '%s'", cmdConfig.TaskName, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	task, workspaces, err := fetchRunTaskTargets(c, cmdConfig.TaskName, cmdConfig.WorkspaceSelectorFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	results := data.AttachRunTask(c, workspaces, task, cmdConfig.EnforcementLevel, cmdConfig.Stages, cmdConfig.Parallelism)
+	return renderRunTaskAttachResults(v, task.Name, results)
+}
+
+func runTaskDetach(cmdConfig *flags.RunTaskDetachFlags) error {
+	v := view.NewRunTaskAttachView()
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Detaching run task '%s' in organization '%s'", cmdConfig.TaskName, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	task, workspaces, err := fetchRunTaskTargets(c, cmdConfig.TaskName, cmdConfig.WorkspaceSelectorFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	results := data.DetachRunTask(c, workspaces, task, cmdConfig.Parallelism)
+	return renderRunTaskAttachResults(v, task.Name, results)
+}
+
+// renderRunTaskAttachResults renders the result for each workspace and exits with code 1 when
+// attaching or detaching failed on any workspace
+func renderRunTaskAttachResults(v *view.RunTaskAttachView, taskName string, results []view.RunTaskAttachResult) error {
+	if err := v.Render(taskName, results); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
+
+// fetchRunTaskTargets reads the run task by name and the workspaces matching the selector
+func fetchRunTaskTargets(c *client.TfxClient, taskName string, f flags.WorkspaceSelectorFlags) (*tfe.RunTask, []*tfe.Workspace, error) {
+	selector := workspaceSelectorFromFlags(f)
+	if selector.IsEmpty() {
+		return nil, nil, errors.New("at least one workspace selector is required (--name, --search, --wildcard-name, --tags, --exclude-tags or --project-name)")
+	}
+
+	task, err := data.FetchRunTaskByName(c, c.OrganizationName, taskName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read run task")
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list workspaces")
+	}
+	if len(workspaces) == 0 {
+		return nil, nil, errors.New("no workspaces matched the selector")
+	}
+	return task, workspaces, nil
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"github.com/straubt1/tfx/pkg/runlog"
+)
+
+type ApplyLogsView struct{ *BaseView }
+
+func NewApplyLogsView() *ApplyLogsView { return &ApplyLogsView{NewBaseView()} }
+
+func (v *ApplyLogsView) Render(logs []string) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(planLogsOutput{
+			Logs: logs,
+		})
+	}
+
+	// Terminal mode: print logs directly
+	for _, line := range logs {
+		v.Output().Message(line)
+	}
+	return nil
+}
+
+// RenderDiagnostics renders only the errors and warnings found in the logs
+func (v *ApplyLogsView) RenderDiagnostics(diagnostics []runlog.Diagnostic) error {
+	return renderLogDiagnostics(v.BaseView, diagnostics)
+}
+
+// RenderGitHubAnnotations renders the errors and warnings found in the logs as GitHub Actions
+// annotations
+func (v *ApplyLogsView) RenderGitHubAnnotations(diagnostics []runlog.Diagnostic) error {
+	return renderGitHubAnnotations(v.BaseView, diagnostics)
+}
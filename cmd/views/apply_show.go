@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+type ApplyShowView struct{ *BaseView }
+
+func NewApplyShowView() *ApplyShowView { return &ApplyShowView{NewBaseView()} }
+
+type applyShowOutput struct {
+	ID                   string                      `json:"id"`
+	Status               string                      `json:"status"`
+	LogReadURL           string                      `json:"logReadUrl"`
+	ResourceAdditions    int                         `json:"resourceAdditions"`
+	ResourceChanges      int                         `json:"resourceChanges"`
+	ResourceDestructions int                         `json:"resourceDestructions"`
+	ResourceImports      int                         `json:"resourceImports"`
+	StatusTimestamps     *planStatusTimestampsOutput `json:"statusTimestamps,omitempty"`
+}
+
+func (v *ApplyShowView) Render(apply *tfe.Apply) error {
+	if v.IsJSON() {
+		var timestamps *planStatusTimestampsOutput
+		if apply.StatusTimestamps != nil {
+			timestamps = &planStatusTimestampsOutput{
+				QueuedAt:        FormatDateTime(apply.StatusTimestamps.QueuedAt),
+				StartedAt:       FormatDateTime(apply.StatusTimestamps.StartedAt),
+				FinishedAt:      FormatDateTime(apply.StatusTimestamps.FinishedAt),
+				CanceledAt:      FormatDateTime(apply.StatusTimestamps.CanceledAt),
+				ErroredAt:       FormatDateTime(apply.StatusTimestamps.ErroredAt),
+				ForceCanceledAt: FormatDateTime(apply.StatusTimestamps.ForceCanceledAt),
+			}
+		}
+		return v.Output().RenderJSON(applyShowOutput{
+			ID:                   apply.ID,
+			Status:               string(apply.Status),
+			LogReadURL:           apply.LogReadURL,
+			ResourceAdditions:    apply.ResourceAdditions,
+			ResourceChanges:      apply.ResourceChanges,
+			ResourceDestructions: apply.ResourceDestructions,
+			ResourceImports:      apply.ResourceImports,
+			StatusTimestamps:     timestamps,
+		})
+	}
+
+	props := []PropertyPair{
+		{Key: "ID", Value: apply.ID},
+		{Key: "Status", Value: string(apply.Status)},
+		{Key: "Resource Additions", Value: apply.ResourceAdditions},
+		{Key: "Resource Changes", Value: apply.ResourceChanges},
+		{Key: "Resource Destructions", Value: apply.ResourceDestructions},
+		{Key: "Resource Imports", Value: apply.ResourceImports},
+	}
+
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+
+	// Render status timestamps as indented tags
+	if apply.StatusTimestamps != nil {
+		statusTags := []PropertyPair{}
+		if !apply.StatusTimestamps.QueuedAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Queued At", Value: FormatDateTime(apply.StatusTimestamps.QueuedAt)})
+		}
+		if !apply.StatusTimestamps.StartedAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Started At", Value: FormatDateTime(apply.StatusTimestamps.StartedAt)})
+		}
+		if !apply.StatusTimestamps.FinishedAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Finished At", Value: FormatDateTime(apply.StatusTimestamps.FinishedAt)})
+		}
+		if !apply.StatusTimestamps.CanceledAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Canceled At", Value: FormatDateTime(apply.StatusTimestamps.CanceledAt)})
+		}
+		if !apply.StatusTimestamps.ErroredAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Errored At", Value: FormatDateTime(apply.StatusTimestamps.ErroredAt)})
+		}
+		if !apply.StatusTimestamps.ForceCanceledAt.IsZero() {
+			statusTags = append(statusTags, PropertyPair{Key: "Force Canceled At", Value: FormatDateTime(apply.StatusTimestamps.ForceCanceledAt)})
+		}
+		if len(statusTags) > 0 {
+			return v.Output().RenderTags("Statuses", statusTags)
+		}
+	}
+
+	return nil
+}
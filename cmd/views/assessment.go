@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Assessment statuses
+const (
+	AssessmentDrifted      = "Drifted"
+	AssessmentChecksFailed = "Checks failed"
+	AssessmentNoDrift      = "No drift"
+	AssessmentErrored      = "Errored"
+	AssessmentNotEnabled   = "Not enabled"
+	AssessmentNone         = "No assessment"
+)
+
+// AssessmentDriftedResource is a resource that changed outside of Terraform
+type AssessmentDriftedResource struct {
+	Address string `json:"address"`
+	Action  string `json:"action"`
+}
+
+// AssessmentFailedCheck is a continuous validation check that failed or errored
+type AssessmentFailedCheck struct {
+	Address  string   `json:"address"`
+	Kind     string   `json:"kind"`
+	Status   string   `json:"status"`
+	Messages []string `json:"messages,omitempty"`
+}
+
+// WorkspaceAssessment is the current health assessment of a workspace
+type WorkspaceAssessment struct {
+	WorkspaceName      string                      `json:"workspaceName"`
+	WorkspaceID        string                      `json:"workspaceId"`
+	ProjectName        string                      `json:"projectName,omitempty"`
+	AssessmentsEnabled bool                        `json:"assessmentsEnabled"`
+	AssessmentResultID string                      `json:"assessmentResultId,omitempty"`
+	Status             string                      `json:"status"`
+	Drifted            bool                        `json:"drifted"`
+	ResourcesDrifted   int                         `json:"resourcesDrifted"`
+	ChecksPassed       int                         `json:"checksPassed"`
+	ChecksFailed       int                         `json:"checksFailed"`
+	ChecksErrored      int                         `json:"checksErrored"`
+	AssessedAt         *time.Time                  `json:"assessedAt,omitempty"`
+	ErrorMessage       string                      `json:"errorMessage,omitempty"`
+	DriftedResources   []AssessmentDriftedResource `json:"driftedResources"`
+	FailedChecks       []AssessmentFailedCheck     `json:"failedChecks"`
+}
+
+// HasFindings returns true if the workspace drifted, has failing checks or the assessment errored
+func (a WorkspaceAssessment) HasFindings() bool {
+	return a.Drifted || a.ChecksFailed > 0 || a.ChecksErrored > 0 || a.Status == AssessmentErrored
+}
+
+func formatAssessedAt(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return FormatDateTime(*t)
+}
+
+// AssessmentShowView handles rendering for the workspace assessment show command
+type AssessmentShowView struct {
+	*BaseView
+}
+
+func NewAssessmentShowView() *AssessmentShowView {
+	return &AssessmentShowView{BaseView: NewBaseView()}
+}
+
+// Render renders a workspace's assessment with its drifted resources and failed checks
+func (v *AssessmentShowView) Render(a WorkspaceAssessment) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(a)
+	}
+
+	properties := []PropertyPair{
+		{Key: "Workspace", Value: a.WorkspaceName},
+		{Key: "Assessments Enabled", Value: a.AssessmentsEnabled},
+		{Key: "Status", Value: a.Status},
+	}
+	if a.AssessmentResultID != "" {
+		properties = append(properties,
+			PropertyPair{Key: "Assessment ID", Value: a.AssessmentResultID},
+			PropertyPair{Key: "Assessed At", Value: formatAssessedAt(a.AssessedAt)},
+			PropertyPair{Key: "Resources Drifted", Value: a.ResourcesDrifted},
+			PropertyPair{Key: "Checks Passed", Value: a.ChecksPassed},
+			PropertyPair{Key: "Checks Failed", Value: a.ChecksFailed},
+			PropertyPair{Key: "Checks Errored", Value: a.ChecksErrored},
+		)
+	}
+	if a.ErrorMessage != "" {
+		properties = append(properties, PropertyPair{Key: "Error", Value: a.ErrorMessage})
+	}
+	if err := v.Output().RenderProperties(properties); err != nil {
+		return err
+	}
+
+	if len(a.DriftedResources) > 0 {
+		v.Output().Message("")
+		v.Output().Message("Drifted Resources:")
+		rows := make([][]interface{}, len(a.DriftedResources))
+		for i, r := range a.DriftedResources {
+			rows[i] = []interface{}{r.Address, r.Action}
+		}
+		if err := v.Output().RenderTable([]string{"Address", "Action"}, rows); err != nil {
+			return err
+		}
+	}
+
+	if len(a.FailedChecks) > 0 {
+		v.Output().Message("")
+		v.Output().Message("Failed Checks:")
+		rows := make([][]interface{}, len(a.FailedChecks))
+		for i, c := range a.FailedChecks {
+			rows[i] = []interface{}{c.Address, c.Kind, c.Status, strings.Join(c.Messages, "; ")}
+		}
+		if err := v.Output().RenderTable([]string{"Address", "Kind", "Status", "Messages"}, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DriftReportView handles rendering for the report drift command
+type DriftReportView struct {
+	*BaseView
+}
+
+func NewDriftReportView() *DriftReportView {
+	return &DriftReportView{BaseView: NewBaseView()}
+}
+
+// Render renders a summary row for each workspace, followed by the drifted resources and
+// failed checks of workspaces with findings
+func (v *DriftReportView) Render(assessments []WorkspaceAssessment) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(assessments)
+	}
+
+	findings := 0
+	headers := []string{"Workspace", "Project", "Status", "Drifted", "Failed Checks", "Assessed At"}
+	rows := make([][]interface{}, len(assessments))
+	for i, a := range assessments {
+		if a.HasFindings() {
+			findings++
+		}
+		rows[i] = []interface{}{a.WorkspaceName, a.ProjectName, a.Status, a.ResourcesDrifted, a.ChecksFailed + a.ChecksErrored, formatAssessedAt(a.AssessedAt)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	var details [][]interface{}
+	for _, a := range assessments {
+		for _, r := range a.DriftedResources {
+			details = append(details, []interface{}{a.WorkspaceName, "resource", r.Address, r.Action})
+		}
+		for _, c := range a.FailedChecks {
+			details = append(details, []interface{}{a.WorkspaceName, "check", c.Address, strings.Join(c.Messages, "; ")})
+		}
+	}
+	if len(details) > 0 {
+		v.Output().Message("")
+		if err := v.Output().RenderTable([]string{"Workspace", "Type", "Address", "Detail"}, details); err != nil {
+			return err
+		}
+	}
+
+	v.Output().Message("%d of %d workspace(s) have drift or failing checks", findings, len(assessments))
+	return nil
+}
+
+// RenderCSV writes the report as CSV to the terminal
+func (v *DriftReportView) RenderCSV(assessments []WorkspaceAssessment) error {
+	var sb strings.Builder
+	if err := WriteDriftReportCSV(&sb, assessments); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
+// RenderFile renders a summary of a report written to a file
+func (v *DriftReportView) RenderFile(assessments []WorkspaceAssessment, outputFile string) error {
+	findings := 0
+	for _, a := range assessments {
+		if a.HasFindings() {
+			findings++
+		}
+	}
+
+	if v.IsJSON() {
+		return v.Output().RenderJSON(map[string]interface{}{
+			"outputFile":   outputFile,
+			"workspaces":   len(assessments),
+			"withFindings": findings,
+		})
+	}
+
+	properties := []PropertyPair{
+		{Key: "Output File", Value: outputFile},
+		{Key: "Workspaces", Value: len(assessments)},
+		{Key: "With Findings", Value: findings},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// WriteDriftReportCSV writes one row per drifted resource or failed check. Workspaces without
+// findings get a single row so every assessed workspace appears in the report.
+func WriteDriftReportCSV(w io.Writer, assessments []WorkspaceAssessment) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"workspace", "workspace_id", "project", "status", "assessed_at", "type", "address", "detail"}); err != nil {
+		return err
+	}
+
+	for _, a := range assessments {
+		assessedAt := ""
+		if a.AssessedAt != nil {
+			assessedAt = a.AssessedAt.UTC().Format(time.RFC3339)
+		}
+		prefix := []string{a.WorkspaceName, a.WorkspaceID, a.ProjectName, a.Status, assessedAt}
+
+		if len(a.DriftedResources) == 0 && len(a.FailedChecks) == 0 {
+			detail := a.ErrorMessage
+			if detail == "" && a.HasFindings() {
+				detail = fmt.Sprintf("%d resource(s) drifted, %d check(s) failed", a.ResourcesDrifted, a.ChecksFailed+a.ChecksErrored)
+			}
+			if err := cw.Write(append(prefix, "", "", detail)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, r := range a.DriftedResources {
+			if err := cw.Write(append(append([]string{}, prefix...), "resource", r.Address, r.Action)); err != nil {
+				return err
+			}
+		}
+		for _, c := range a.FailedChecks {
+			if err := cw.Write(append(append([]string{}, prefix...), "check", c.Address, strings.Join(c.Messages, "; "))); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/straubt1/tfx/pkg/costestimate"
+)
+
+// WorkspaceCost is the monthly cost of a workspace, from the cost estimate of its latest
+// applied run. Workspaces without one are reported with Estimated false.
+type WorkspaceCost struct {
+	WorkspaceName  string     `json:"workspaceName"`
+	WorkspaceID    string     `json:"workspaceId"`
+	ProjectName    string     `json:"projectName,omitempty"`
+	Estimated      bool       `json:"estimated"`
+	RunID          string     `json:"runId,omitempty"`
+	CostEstimateID string     `json:"costEstimateId,omitempty"`
+	AppliedAt      *time.Time `json:"appliedAt,omitempty"`
+	MonthlyCost    float64    `json:"monthlyCost"`
+}
+
+// ProjectCost is the total monthly cost of the workspaces in a project
+type ProjectCost struct {
+	ProjectName         string  `json:"projectName"`
+	Workspaces          int     `json:"workspaces"`
+	EstimatedWorkspaces int     `json:"estimatedWorkspaces"`
+	MonthlyCost         float64 `json:"monthlyCost"`
+}
+
+// CostByProject totals workspace costs per project, most expensive first
+func CostByProject(costs []WorkspaceCost) []ProjectCost {
+	byName := map[string]*ProjectCost{}
+	var projects []*ProjectCost
+	for _, c := range costs {
+		p, ok := byName[c.ProjectName]
+		if !ok {
+			p = &ProjectCost{ProjectName: c.ProjectName}
+			byName[c.ProjectName] = p
+			projects = append(projects, p)
+		}
+		p.Workspaces++
+		if c.Estimated {
+			p.EstimatedWorkspaces++
+			p.MonthlyCost += c.MonthlyCost
+		}
+	}
+
+	totals := make([]ProjectCost, len(projects))
+	for i, p := range projects {
+		totals[i] = *p
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		if totals[i].MonthlyCost != totals[j].MonthlyCost {
+			return totals[i].MonthlyCost > totals[j].MonthlyCost
+		}
+		return totals[i].ProjectName < totals[j].ProjectName
+	})
+	return totals
+}
+
+func totalMonthlyCost(costs []WorkspaceCost) (float64, int) {
+	total, estimated := 0.0, 0
+	for _, c := range costs {
+		if c.Estimated {
+			total += c.MonthlyCost
+			estimated++
+		}
+	}
+	return total, estimated
+}
+
+func formatWorkspaceCost(c WorkspaceCost) string {
+	if !c.Estimated {
+		return "no estimate"
+	}
+	return costestimate.FormatAmount(c.MonthlyCost)
+}
+
+func formatAppliedAt(c WorkspaceCost) string {
+	if c.AppliedAt == nil {
+		return ""
+	}
+	return FormatDateTime(*c.AppliedAt)
+}
+
+// CostReportView handles rendering for the report cost command
+type CostReportView struct {
+	*BaseView
+}
+
+func NewCostReportView() *CostReportView {
+	return &CostReportView{BaseView: NewBaseView()}
+}
+
+// Render renders the monthly cost of each workspace, most expensive first
+func (v *CostReportView) Render(costs []WorkspaceCost) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(costs)
+	}
+
+	headers := []string{"Workspace", "Project", "Run", "Applied", "Monthly Cost"}
+	rows := make([][]interface{}, len(costs))
+	for i, c := range costs {
+		rows[i] = []interface{}{c.WorkspaceName, c.ProjectName, c.RunID, formatAppliedAt(c), formatWorkspaceCost(c)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	total, estimated := totalMonthlyCost(costs)
+	v.Output().Message("%s per month across %d of %d workspace(s) with a cost estimate", costestimate.FormatAmount(total), estimated, len(costs))
+	return nil
+}
+
+// RenderProjects renders the total monthly cost of each project, most expensive first
+func (v *CostReportView) RenderProjects(projects []ProjectCost) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(projects)
+	}
+
+	headers := []string{"Project", "Workspaces", "Estimated", "Monthly Cost"}
+	rows := make([][]interface{}, len(projects))
+	total := 0.0
+	for i, p := range projects {
+		rows[i] = []interface{}{p.ProjectName, p.Workspaces, p.EstimatedWorkspaces, costestimate.FormatAmount(p.MonthlyCost)}
+		total += p.MonthlyCost
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	v.Output().Message("%s per month across %d project(s)", costestimate.FormatAmount(total), len(projects))
+	return nil
+}
+
+// RenderCSV writes the report as CSV to the terminal, using write to produce it
+func (v *CostReportView) RenderCSV(write func(io.Writer) error) error {
+	var sb strings.Builder
+	if err := write(&sb); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
+// RenderFile renders a summary of a report written to a file
+func (v *CostReportView) RenderFile(costs []WorkspaceCost, outputFile string) error {
+	total, estimated := totalMonthlyCost(costs)
+	if v.IsJSON() {
+		return v.Output().RenderJSON(map[string]interface{}{
+			"outputFile":          outputFile,
+			"workspaces":          len(costs),
+			"estimatedWorkspaces": estimated,
+			"monthlyCost":         total,
+		})
+	}
+
+	properties := []PropertyPair{
+		{Key: "Output File", Value: outputFile},
+		{Key: "Workspaces", Value: len(costs)},
+		{Key: "Estimated", Value: estimated},
+		{Key: "Monthly Cost", Value: costestimate.FormatAmount(total)},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// WriteCostReportCSV writes one row per workspace, costs are plain decimals so they can be
+// summed in a spreadsheet
+func WriteCostReportCSV(w io.Writer, costs []WorkspaceCost) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"workspace", "workspace_id", "project", "estimated", "run_id", "cost_estimate_id", "applied_at", "monthly_cost"}); err != nil {
+		return err
+	}
+
+	for _, c := range costs {
+		appliedAt := ""
+		if c.AppliedAt != nil {
+			appliedAt = c.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		record := []string{
+			c.WorkspaceName,
+			c.WorkspaceID,
+			c.ProjectName,
+			strconv.FormatBool(c.Estimated),
+			c.RunID,
+			c.CostEstimateID,
+			appliedAt,
+			strconv.FormatFloat(c.MonthlyCost, 'f', 2, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteProjectCostReportCSV writes one row per project
+func WriteProjectCostReportCSV(w io.Writer, projects []ProjectCost) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"project", "workspaces", "estimated_workspaces", "monthly_cost"}); err != nil {
+		return err
+	}
+
+	for _, p := range projects {
+		record := []string{
+			p.ProjectName,
+			strconv.Itoa(p.Workspaces),
+			strconv.Itoa(p.EstimatedWorkspaces),
+			strconv.FormatFloat(p.MonthlyCost, 'f', 2, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testWorkspaceCosts = []WorkspaceCost{
+	{WorkspaceName: "network-prod", ProjectName: "platform", Estimated: true, RunID: "run-1", MonthlyCost: 412.5},
+	{WorkspaceName: "app-dev", ProjectName: "apps", Estimated: true, RunID: "run-2", MonthlyCost: 120},
+	{WorkspaceName: "network-dev", ProjectName: "platform", Estimated: true, RunID: "run-3", MonthlyCost: 80.25},
+	{WorkspaceName: "sandbox", ProjectName: "apps"},
+}
+
+func TestCostByProject(t *testing.T) {
+	got := CostByProject(testWorkspaceCosts)
+	want := []ProjectCost{
+		{ProjectName: "platform", Workspaces: 2, EstimatedWorkspaces: 2, MonthlyCost: 492.75},
+		{ProjectName: "apps", Workspaces: 2, EstimatedWorkspaces: 1, MonthlyCost: 120},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CostByProject() = %+v, want %+v", got, want)
+	}
+}
+
+func TestWriteCostReportCSV(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteCostReportCSV(&sb, testWorkspaceCosts); err != nil {
+		t.Fatalf("WriteCostReportCSV() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want a header and 4 rows", len(lines))
+	}
+	if want := "network-prod,,platform,true,run-1,,,412.50"; lines[1] != want {
+		t.Errorf("row = %q, want %q", lines[1], want)
+	}
+	if want := "sandbox,,apps,false,,,,0.00"; lines[4] != want {
+		t.Errorf("row = %q, want %q", lines[4], want)
+	}
+}
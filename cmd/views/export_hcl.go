@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+// ExportedFile summarizes one file written by an export
+type ExportedFile struct {
+	Path      string `json:"path"`
+	Resources int    `json:"resources"`
+	Imports   int    `json:"imports"`
+	Variables int    `json:"variables"`
+}
+
+// ExportHCLView handles rendering for the export hcl command
+type ExportHCLView struct {
+	*BaseView
+}
+
+func NewExportHCLView() *ExportHCLView {
+	return &ExportHCLView{BaseView: NewBaseView()}
+}
+
+type exportHCLOutput struct {
+	Directory string         `json:"directory"`
+	Files     []ExportedFile `json:"files"`
+}
+
+// Render renders the files written by the export
+func (v *ExportHCLView) Render(directory string, files []ExportedFile) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(exportHCLOutput{Directory: directory, Files: files})
+	}
+
+	headers := []string{"File", "Resources", "Imports", "Variables"}
+	rows := make([][]interface{}, len(files))
+	resources := 0
+	for i, f := range files {
+		rows[i] = []interface{}{f.Path, f.Resources, f.Imports, f.Variables}
+		resources += f.Resources
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	v.Output().Message("Exported %d resources to %s", resources, directory)
+	v.Output().Message("Set values for any sensitive variables, then run: terraform plan")
+	return nil
+}
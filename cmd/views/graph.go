@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"strings"
+
+	"github.com/straubt1/tfx/pkg/depgraph"
+)
+
+// GraphView handles rendering for the graph command
+type GraphView struct {
+	*BaseView
+}
+
+func NewGraphView() *GraphView {
+	return &GraphView{BaseView: NewBaseView()}
+}
+
+// Render prints the rendered graph so it can be piped into other tools.
+// JSON output mode always renders the graph as JSON.
+func (v *GraphView) Render(g *depgraph.Graph, rendered string) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(g)
+	}
+	v.Output().Message("%s", strings.TrimSuffix(rendered, "\n"))
+	return nil
+}
+
+type graphFileOutput struct {
+	OutputFile   string `json:"outputFile"`
+	Format       string `json:"format"`
+	Workspaces   int    `json:"workspaces"`
+	RunTriggers  int    `json:"runTriggers"`
+	RemoteState  int    `json:"remoteState"`
+	GlobalShares int    `json:"globalRemoteState"`
+}
+
+// RenderFile renders a summary of a graph written to a file
+func (v *GraphView) RenderFile(g *depgraph.Graph, format string, outputFile string) error {
+	out := graphFileOutput{
+		OutputFile: outputFile,
+		Format:     format,
+		Workspaces: len(g.Nodes),
+	}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case depgraph.EdgeRunTrigger:
+			out.RunTriggers++
+		case depgraph.EdgeRemoteState:
+			out.RemoteState++
+		}
+	}
+	for _, n := range g.Nodes {
+		if n.GlobalRemoteState {
+			out.GlobalShares++
+		}
+	}
+
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	properties := []PropertyPair{
+		{Key: "Output File", Value: out.OutputFile},
+		{Key: "Format", Value: out.Format},
+		{Key: "Workspaces", Value: out.Workspaces},
+		{Key: "Run Triggers", Value: out.RunTriggers},
+		{Key: "Remote State Consumers", Value: out.RemoteState},
+		{Key: "Global State Sharing", Value: out.GlobalShares},
+	}
+	return v.Output().RenderProperties(properties)
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"strings"
+
+	"github.com/straubt1/tfx/pkg/runlog"
+)
+
+type logDiagnosticsOutput struct {
+	Errors      int                 `json:"errors"`
+	Warnings    int                 `json:"warnings"`
+	Diagnostics []runlog.Diagnostic `json:"diagnostics"`
+}
+
+// renderLogDiagnostics renders the errors and warnings found in plan or apply logs, each with
+// the file:line it refers to, followed by the detail
+func renderLogDiagnostics(v *BaseView, diagnostics []runlog.Diagnostic) error {
+	out := logDiagnosticsOutput{Diagnostics: diagnostics}
+	for _, d := range diagnostics {
+		if d.Severity == runlog.SeverityWarning {
+			out.Warnings++
+		} else {
+			out.Errors++
+		}
+	}
+	if out.Diagnostics == nil {
+		out.Diagnostics = []runlog.Diagnostic{}
+	}
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	for _, d := range diagnostics {
+		source := d.Location()
+		if source == "" {
+			source = d.Address
+		}
+		if source != "" {
+			v.Output().Message("%s: %s: %s", source, d.Severity, d.Summary)
+		} else {
+			v.Output().Message("%s: %s", d.Severity, d.Summary)
+		}
+		for _, line := range strings.Split(strings.TrimSpace(d.Detail), "\n") {
+			if line != "" {
+				v.Output().Message("    %s", line)
+			}
+		}
+	}
+	v.Output().Message("%d error(s), %d warning(s)", out.Errors, out.Warnings)
+	return nil
+}
+
+// renderGitHubAnnotations prints each diagnostic as a GitHub Actions annotation
+func renderGitHubAnnotations(v *BaseView, diagnostics []runlog.Diagnostic) error {
+	if v.IsJSON() {
+		return renderLogDiagnostics(v, diagnostics)
+	}
+	for _, d := range diagnostics {
+		v.Output().Message("%s", d.GitHubAnnotation())
+	}
+	return nil
+}
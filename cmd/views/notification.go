@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Notification template apply statuses
+const (
+	NotificationCreate  = "Create"
+	NotificationUpdate  = "Update"
+	NotificationCreated = "Created"
+	NotificationUpdated = "Updated"
+	NotificationFailed  = "Failed"
+)
+
+// notificationOutput is a JSON-safe representation of a notification configuration.
+// The token is write only and never rendered.
+type notificationOutput struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	DestinationType string   `json:"destinationType"`
+	Enabled         bool     `json:"enabled"`
+	Triggers        []string `json:"triggers"`
+	URL             string   `json:"url,omitempty"`
+	EmailAddresses  []string `json:"emailAddresses,omitempty"`
+}
+
+func newNotificationOutput(nc *tfe.NotificationConfiguration) notificationOutput {
+	triggers := nc.Triggers
+	if triggers == nil {
+		triggers = []string{}
+	}
+	return notificationOutput{
+		ID:              nc.ID,
+		Name:            nc.Name,
+		DestinationType: string(nc.DestinationType),
+		Enabled:         nc.Enabled,
+		Triggers:        triggers,
+		URL:             nc.URL,
+		EmailAddresses:  nc.EmailAddresses,
+	}
+}
+
+// notificationDestination returns the URL or email recipients of a notification configuration
+func notificationDestination(nc *tfe.NotificationConfiguration) string {
+	if nc.DestinationType == tfe.NotificationDestinationTypeEmail {
+		recipients := append([]string{}, nc.EmailAddresses...)
+		for _, u := range nc.EmailUsers {
+			if u != nil && u.Username != "" {
+				recipients = append(recipients, u.Username)
+			}
+		}
+		return strings.Join(recipients, ", ")
+	}
+	return nc.URL
+}
+
+// NotificationListView handles rendering for the notification list command
+type NotificationListView struct {
+	*BaseView
+}
+
+func NewNotificationListView() *NotificationListView {
+	return &NotificationListView{BaseView: NewBaseView()}
+}
+
+// Render renders the notification configurations of a workspace
+func (v *NotificationListView) Render(configs []*tfe.NotificationConfiguration) error {
+	if v.IsJSON() {
+		out := make([]notificationOutput, len(configs))
+		for i, nc := range configs {
+			out[i] = newNotificationOutput(nc)
+		}
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"Name", "ID", "Destination", "Enabled", "Triggers", "Target"}
+	rows := make([][]interface{}, len(configs))
+	for i, nc := range configs {
+		rows[i] = []interface{}{nc.Name, nc.ID, nc.DestinationType, nc.Enabled, strings.Join(nc.Triggers, ", "), notificationDestination(nc)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// NotificationView handles rendering for the notification create and update commands
+type NotificationView struct {
+	*BaseView
+}
+
+func NewNotificationView() *NotificationView {
+	return &NotificationView{BaseView: NewBaseView()}
+}
+
+// Render renders a notification configuration's details
+func (v *NotificationView) Render(nc *tfe.NotificationConfiguration) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(newNotificationOutput(nc))
+	}
+
+	properties := []PropertyPair{
+		{Key: "ID", Value: nc.ID},
+		{Key: "Name", Value: nc.Name},
+		{Key: "Destination", Value: nc.DestinationType},
+		{Key: "Enabled", Value: nc.Enabled},
+		{Key: "Triggers", Value: strings.Join(nc.Triggers, ", ")},
+		{Key: "Target", Value: notificationDestination(nc)},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// NotificationDeleteView handles rendering for the notification delete command
+type NotificationDeleteView struct {
+	*BaseView
+}
+
+func NewNotificationDeleteView() *NotificationDeleteView {
+	return &NotificationDeleteView{BaseView: NewBaseView()}
+}
+
+type notificationDeleteOutput struct {
+	Status string `json:"status"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+}
+
+// Render renders a successful delete operation
+func (v *NotificationDeleteView) Render(nc *tfe.NotificationConfiguration) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(notificationDeleteOutput{Status: "Success", ID: nc.ID, Name: nc.Name})
+	}
+
+	properties := []PropertyPair{
+		{Key: "Status", Value: "Success"},
+		{Key: "ID", Value: nc.ID},
+		{Key: "Name", Value: nc.Name},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// NotificationVerifyView handles rendering for the notification verify command
+type NotificationVerifyView struct {
+	*BaseView
+}
+
+func NewNotificationVerifyView() *NotificationVerifyView {
+	return &NotificationVerifyView{BaseView: NewBaseView()}
+}
+
+type notificationDeliveryOutput struct {
+	URL        string `json:"url"`
+	Code       string `json:"code"`
+	Successful bool   `json:"successful"`
+	SentAt     string `json:"sentAt"`
+	Body       string `json:"body,omitempty"`
+}
+
+type notificationVerifyOutput struct {
+	ID         string                       `json:"id"`
+	Name       string                       `json:"name"`
+	Successful bool                         `json:"successful"`
+	Deliveries []notificationDeliveryOutput `json:"deliveries"`
+}
+
+// NotificationDelivered returns true if the test notification was delivered to every destination
+func NotificationDelivered(nc *tfe.NotificationConfiguration) bool {
+	if len(nc.DeliveryResponses) == 0 {
+		return false
+	}
+	for _, r := range nc.DeliveryResponses {
+		if r.Successful != "true" {
+			return false
+		}
+	}
+	return true
+}
+
+// Render renders the delivery responses of a test notification
+func (v *NotificationVerifyView) Render(nc *tfe.NotificationConfiguration) error {
+	if v.IsJSON() {
+		out := notificationVerifyOutput{
+			ID:         nc.ID,
+			Name:       nc.Name,
+			Successful: NotificationDelivered(nc),
+			Deliveries: []notificationDeliveryOutput{},
+		}
+		for _, r := range nc.DeliveryResponses {
+			out.Deliveries = append(out.Deliveries, notificationDeliveryOutput{
+				URL:        r.URL,
+				Code:       r.Code,
+				Successful: r.Successful == "true",
+				SentAt:     FormatDateTime(r.SentAt),
+				Body:       r.Body,
+			})
+		}
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"URL", "Code", "Successful", "Sent At"}
+	rows := make([][]interface{}, len(nc.DeliveryResponses))
+	for i, r := range nc.DeliveryResponses {
+		rows[i] = []interface{}{r.URL, r.Code, r.Successful == "true", FormatDateTime(r.SentAt)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// NotificationApplyResult is the outcome of applying a notification template to one workspace
+type NotificationApplyResult struct {
+	WorkspaceName  string `json:"workspaceName"`
+	WorkspaceID    string `json:"workspaceId"`
+	NotificationID string `json:"notificationId,omitempty"`
+	Status         string `json:"status"`
+	Error          string `json:"error,omitempty"`
+}
+
+// Failed returns true if applying the template to the workspace returned an error
+func (r NotificationApplyResult) Failed() bool {
+	return r.Error != ""
+}
+
+// NotificationApplyView handles rendering for the notification apply command
+type NotificationApplyView struct {
+	*BaseView
+}
+
+func NewNotificationApplyView() *NotificationApplyView {
+	return &NotificationApplyView{BaseView: NewBaseView()}
+}
+
+type notificationApplyOutput struct {
+	Name    string                    `json:"name"`
+	DryRun  bool                      `json:"dryRun"`
+	Results []NotificationApplyResult `json:"results"`
+}
+
+// Render renders the result of applying a template to each workspace
+func (v *NotificationApplyView) Render(name string, results []NotificationApplyResult, dryRun bool) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(notificationApplyOutput{Name: name, DryRun: dryRun, Results: results})
+	}
+
+	headers := []string{"Workspace", "Notification ID", "Status"}
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.WorkspaceName, r.NotificationID, statusWithError(r.Status, r.Error)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	if dryRun {
+		v.Output().Message("Dry run, no changes were made.")
+	}
+	return nil
+}
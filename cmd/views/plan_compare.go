@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"strings"
+
+	"github.com/straubt1/tfx/pkg/planjson"
+)
+
+type PlanCompareView struct{ *BaseView }
+
+func NewPlanCompareView() *PlanCompareView { return &PlanCompareView{NewBaseView()} }
+
+type planCompareOutput struct {
+	PlanID      string `json:"planId"`
+	OtherPlanID string `json:"otherPlanId"`
+	Differences int    `json:"differences"`
+	planjson.Comparison
+}
+
+// Render renders the resources that differ between two plans
+func (v *PlanCompareView) Render(planID string, otherPlanID string, c planjson.Comparison) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(planCompareOutput{
+			PlanID:      planID,
+			OtherPlanID: otherPlanID,
+			Differences: c.Differences(),
+			Comparison:  c,
+		})
+	}
+
+	props := []PropertyPair{
+		{Key: "Plan", Value: planID},
+		{Key: "Other Plan", Value: otherPlanID},
+		{Key: "Differences", Value: c.Differences()},
+	}
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+	if c.Differences() == 0 {
+		v.Output().Message("Both plans make the same changes")
+		return nil
+	}
+
+	if len(c.Added) > 0 {
+		v.Output().Message("Appeared in '%s' (%d):", otherPlanID, len(c.Added))
+		if err := v.Output().RenderTable([]string{"Address", "Action"}, resourceSummaryRows(c.Added)); err != nil {
+			return err
+		}
+	}
+	if len(c.Removed) > 0 {
+		v.Output().Message("Disappeared from '%s' (%d):", otherPlanID, len(c.Removed))
+		if err := v.Output().RenderTable([]string{"Address", "Action"}, resourceSummaryRows(c.Removed)); err != nil {
+			return err
+		}
+	}
+	if len(c.ActionChanged) > 0 {
+		v.Output().Message("Action Changed (%d):", len(c.ActionChanged))
+		rows := make([][]interface{}, len(c.ActionChanged))
+		for i, a := range c.ActionChanged {
+			rows[i] = []interface{}{a.Address, a.Action, a.OtherAction}
+		}
+		if err := v.Output().RenderTable([]string{"Address", planID, otherPlanID}, rows); err != nil {
+			return err
+		}
+	}
+	if len(c.AttributesChanged) > 0 {
+		v.Output().Message("Attribute Diffs Changed (%d):", len(c.AttributesChanged))
+		rows := make([][]interface{}, len(c.AttributesChanged))
+		for i, a := range c.AttributesChanged {
+			rows[i] = []interface{}{a.Address, a.Action, strings.Join(a.Attributes, ", ")}
+		}
+		if err := v.Output().RenderTable([]string{"Address", "Action", "Attributes"}, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceSummaryRows(resources []planjson.ResourceSummary) [][]interface{} {
+	rows := make([][]interface{}, len(resources))
+	for i, r := range resources {
+		rows[i] = []interface{}{r.Address, strings.Join(r.Kinds, ", ")}
+	}
+	return rows
+}
@@ -13,11 +13,12 @@ import (
 type PlanCreateView struct{ *BaseView }
 
 type PlanCreateRenderOptions struct {
-	RunID        string
-	PlanID       string
-	Hostname     string
-	Organization string
-	Workspace    string
+	RunID                  string
+	PlanID                 string
+	ConfigurationVersionID string
+	Hostname               string
+	Organization           string
+	Workspace              string
 }
 
 func NewPlanCreateView() *PlanCreateView { return &PlanCreateView{NewBaseView()} }
@@ -91,6 +92,9 @@ func (v *PlanCreateView) Render(plan *tfe.Plan, opts *PlanCreateRenderOptions) e
 	if opts != nil {
 		v.Output().Message("Run ID: %s", color.BlueString(opts.RunID))
 		v.Output().Message("Plan ID: %s", color.BlueString(opts.PlanID))
+		if opts.ConfigurationVersionID != "" {
+			v.Output().Message("Configuration Version ID: %s", color.BlueString(opts.ConfigurationVersionID))
+		}
 		v.Output().Message("Navigate: %s", color.BlueString(fmt.Sprintf("https://%s/app/%s/workspaces/%s/runs/%s", opts.Hostname, opts.Organization, opts.Workspace, opts.RunID)))
 	}
 
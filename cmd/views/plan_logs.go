@@ -3,6 +3,10 @@
 
 package view
 
+import (
+	"github.com/straubt1/tfx/pkg/runlog"
+)
+
 type PlanLogsView struct{ *BaseView }
 
 func NewPlanLogsView() *PlanLogsView { return &PlanLogsView{NewBaseView()} }
@@ -24,3 +28,14 @@ func (v *PlanLogsView) Render(logs []string) error {
 	}
 	return nil
 }
+
+// RenderDiagnostics renders only the errors and warnings found in the logs
+func (v *PlanLogsView) RenderDiagnostics(diagnostics []runlog.Diagnostic) error {
+	return renderLogDiagnostics(v.BaseView, diagnostics)
+}
+
+// RenderGitHubAnnotations renders the errors and warnings found in the logs as GitHub Actions
+// annotations
+func (v *PlanLogsView) RenderGitHubAnnotations(diagnostics []runlog.Diagnostic) error {
+	return renderGitHubAnnotations(v.BaseView, diagnostics)
+}
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/straubt1/tfx/pkg/planjson"
+)
+
+// PlannedResourceChange is a resource the plan will change
+type PlannedResourceChange struct {
+	Address string `json:"address"`
+	Action  string `json:"action"`
+}
+
+// RunPlanSummary summarizes the plan of a run
+type RunPlanSummary struct {
+	RunID           string                  `json:"runId"`
+	WorkspaceName   string                  `json:"workspaceName"`
+	Message         string                  `json:"message"`
+	Status          string                  `json:"status"`
+	Additions       int                     `json:"additions"`
+	Changes         int                     `json:"changes"`
+	Destructions    int                     `json:"destructions"`
+	ResourceChanges []PlannedResourceChange `json:"resourceChanges"`
+}
+
+// renderPlanSummary renders a plan summary as properties followed by the resources that change
+func renderPlanSummary(v *BaseView, summary RunPlanSummary) error {
+	props := []PropertyPair{
+		{Key: "Run ID", Value: summary.RunID},
+		{Key: "Workspace", Value: summary.WorkspaceName},
+		{Key: "Message", Value: summary.Message},
+		{Key: "Status", Value: summary.Status},
+		{Key: "Resources", Value: formatResourceChanges(summary.Additions, summary.Changes, summary.Destructions)},
+	}
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+	if len(summary.ResourceChanges) == 0 {
+		return nil
+	}
+
+	rows := make([][]interface{}, len(summary.ResourceChanges))
+	for i, r := range summary.ResourceChanges {
+		rows[i] = []interface{}{r.Action, r.Address}
+	}
+	return v.Output().RenderTable([]string{"Action", "Address"}, rows)
+}
+
+// planSummaryKindTitles are the section titles for each kind of change
+var planSummaryKindTitles = map[string]string{
+	planjson.ActionCreate:  "Create",
+	planjson.ActionUpdate:  "Update",
+	planjson.ActionReplace: "Replace",
+	planjson.ActionDelete:  "Delete",
+	planjson.ActionRead:    "Read",
+	planjson.KindImport:    "Import",
+	planjson.KindMove:      "Move",
+}
+
+type PlanSummaryView struct{ *BaseView }
+
+func NewPlanSummaryView() *PlanSummaryView { return &PlanSummaryView{NewBaseView()} }
+
+type planSummaryOutput struct {
+	PlanID string `json:"planId"`
+	planjson.Summary
+}
+
+// Render renders the resource changes of a plan grouped by action, module and provider
+func (v *PlanSummaryView) Render(planID string, summary planjson.Summary) error {
+	if v.IsJSON() {
+		return v.RenderJSON(planID, summary)
+	}
+
+	props := []PropertyPair{
+		{Key: "Plan ID", Value: planID},
+		{Key: "Terraform Version", Value: summary.TerraformVersion},
+		{Key: "Changes", Value: formatPlanSummaryCounts(summary)},
+	}
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+	if warning := planSummaryWarning(summary); warning != "" {
+		v.Output().Message("WARNING: %s", warning)
+	}
+
+	for _, kind := range planjson.SummaryKinds {
+		resources := summary.ByKind(kind)
+		if len(resources) == 0 {
+			continue
+		}
+		v.Output().Message("%s (%d):", planSummaryKindTitles[kind], len(resources))
+		rows := make([][]interface{}, len(resources))
+		for i, r := range resources {
+			rows[i] = []interface{}{r.Address, planSummaryDetails(r, kind)}
+		}
+		if err := v.Output().RenderTable([]string{"Address", "Details"}, rows); err != nil {
+			return err
+		}
+	}
+	if len(summary.Resources) == 0 {
+		return nil
+	}
+
+	v.Output().Message("By Module:")
+	if err := v.Output().RenderTable(planSummaryGroupHeaders("Module"), planSummaryGroupRows(summary.Modules)); err != nil {
+		return err
+	}
+	v.Output().Message("By Provider:")
+	return v.Output().RenderTable(planSummaryGroupHeaders("Provider"), planSummaryGroupRows(summary.Providers))
+}
+
+// RenderJSON renders the plan summary as JSON, regardless of the output mode
+func (v *PlanSummaryView) RenderJSON(planID string, summary planjson.Summary) error {
+	return v.Output().RenderJSON(planSummaryOutput{PlanID: planID, Summary: summary})
+}
+
+// RenderMarkdown prints the plan summary as Markdown, for posting as a pull request comment
+func (v *PlanSummaryView) RenderMarkdown(planID string, summary planjson.Summary) error {
+	var sb strings.Builder
+	if err := WritePlanSummaryMarkdown(&sb, planID, summary); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
+// WritePlanSummaryMarkdown writes the plan summary as Markdown, with the module and provider
+// breakdowns in collapsed sections
+func WritePlanSummaryMarkdown(w io.Writer, planID string, summary planjson.Summary) error {
+	var sb strings.Builder
+	sb.WriteString("### Terraform Plan Summary\n\n")
+	fmt.Fprintf(&sb, "**Plan:** `%s`", planID)
+	if summary.TerraformVersion != "" {
+		fmt.Fprintf(&sb, " (Terraform %s)", summary.TerraformVersion)
+	}
+	fmt.Fprintf(&sb, "\n\n**Changes:** %s\n", formatPlanSummaryCounts(summary))
+	if warning := planSummaryWarning(summary); warning != "" {
+		fmt.Fprintf(&sb, "\n> [!WARNING]\n> %s\n", warning)
+	}
+
+	for _, kind := range planjson.SummaryKinds {
+		resources := summary.ByKind(kind)
+		if len(resources) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n#### %s (%d)\n\n| Address | Details |\n| --- | --- |\n", planSummaryKindTitles[kind], len(resources))
+		for _, r := range resources {
+			fmt.Fprintf(&sb, "| `%s` | %s |\n", markdownCell(r.Address), markdownCell(planSummaryDetails(r, kind)))
+		}
+	}
+
+	if len(summary.Resources) > 0 {
+		writeMarkdownGroups(&sb, "Module", summary.Modules)
+		writeMarkdownGroups(&sb, "Provider", summary.Providers)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMarkdownGroups(sb *strings.Builder, title string, groups []planjson.GroupSummary) {
+	headers := planSummaryGroupHeaders(title)
+	fmt.Fprintf(sb, "\n<details><summary>By %s</summary>\n\n| %s |\n|%s\n", strings.ToLower(title),
+		strings.Join(headers, " | "), strings.Repeat(" --- |", len(headers)))
+	for _, row := range planSummaryGroupRows(groups) {
+		cells := make([]string, len(row))
+		for i, c := range row {
+			cells[i] = markdownCell(fmt.Sprint(c))
+		}
+		fmt.Fprintf(sb, "| %s |\n", strings.Join(cells, " | "))
+	}
+	sb.WriteString("\n</details>\n")
+}
+
+// formatPlanSummaryCounts describes how many resources each kind of change affects
+func formatPlanSummaryCounts(summary planjson.Summary) string {
+	var parts []string
+	for _, kind := range planjson.SummaryKinds {
+		if n := summary.Counts[kind]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d to %s", n, kind))
+		}
+	}
+	if len(parts) == 0 {
+		return "No changes"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// planSummaryWarning flags plans that delete or replace resources
+func planSummaryWarning(summary planjson.Summary) string {
+	deletes, replaces := summary.Counts[planjson.ActionDelete], summary.Counts[planjson.ActionReplace]
+	switch {
+	case deletes > 0 && replaces > 0:
+		return fmt.Sprintf("This plan deletes %d and replaces %d resource(s)", deletes, replaces)
+	case deletes > 0:
+		return fmt.Sprintf("This plan deletes %d resource(s)", deletes)
+	case replaces > 0:
+		return fmt.Sprintf("This plan replaces %d resource(s)", replaces)
+	}
+	return ""
+}
+
+// planSummaryDetails describes a resource change for the section of the given kind
+func planSummaryDetails(r planjson.ResourceSummary, kind string) string {
+	switch kind {
+	case planjson.ActionUpdate, planjson.ActionReplace:
+		names := make([]string, len(r.ChangedAttributes))
+		for i, a := range r.ChangedAttributes {
+			names[i] = a.Name
+			switch {
+			case a.Sensitive:
+				names[i] += " (sensitive)"
+			case a.KnownAfter:
+				names[i] += " (known after apply)"
+			}
+		}
+		return strings.Join(names, ", ")
+	case planjson.KindImport:
+		if r.ImportID != "" {
+			return "id " + r.ImportID
+		}
+	case planjson.KindMove:
+		return "from " + r.PreviousAddress
+	}
+	return ""
+}
+
+func planSummaryGroupHeaders(name string) []string {
+	headers := []string{name}
+	for _, kind := range planjson.SummaryKinds {
+		headers = append(headers, planSummaryKindTitles[kind])
+	}
+	return append(headers, "Total")
+}
+
+func planSummaryGroupRows(groups []planjson.GroupSummary) [][]interface{} {
+	rows := make([][]interface{}, len(groups))
+	for i, g := range groups {
+		row := []interface{}{g.Name}
+		for _, kind := range planjson.SummaryKinds {
+			row = append(row, g.Counts[kind])
+		}
+		rows[i] = append(row, g.Total)
+	}
+	return rows
+}
+
+// markdownCell escapes a value for a Markdown table cell
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/straubt1/tfx/pkg/planjson"
+)
+
+var testPlanSummary = planjson.Summary{
+	TerraformVersion: "1.9.5",
+	Counts:           map[string]int{planjson.ActionUpdate: 1, planjson.ActionDelete: 1},
+	Resources: []planjson.ResourceSummary{
+		{
+			Address: "aws_db_instance.main", Module: planjson.RootModule, Provider: "hashicorp/aws",
+			Action: planjson.ActionUpdate, Kinds: []string{planjson.ActionUpdate},
+			ChangedAttributes: []planjson.ChangedAttribute{{Name: "instance_class"}, {Name: "password", Sensitive: true}},
+		},
+		{
+			Address: "random_pet.name", Module: planjson.RootModule, Provider: "hashicorp/random",
+			Action: planjson.ActionDelete, Kinds: []string{planjson.ActionDelete}, Destructive: true,
+		},
+	},
+	Modules: []planjson.GroupSummary{
+		{Name: planjson.RootModule, Counts: map[string]int{planjson.ActionUpdate: 1, planjson.ActionDelete: 1}, Total: 2},
+	},
+	Providers: []planjson.GroupSummary{
+		{Name: "hashicorp/aws", Counts: map[string]int{planjson.ActionUpdate: 1}, Total: 1},
+		{Name: "hashicorp/random", Counts: map[string]int{planjson.ActionDelete: 1}, Total: 1},
+	},
+}
+
+func TestPlanSummaryView_Render(t *testing.T) {
+	v := NewPlanSummaryView()
+	out := captureOutput(t, func() error {
+		return v.Render("plan-abc123", testPlanSummary)
+	})
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if result["planId"] != "plan-abc123" {
+		t.Errorf("planId = %v, want plan-abc123", result["planId"])
+	}
+	if resources, ok := result["resources"].([]interface{}); !ok || len(resources) != 2 {
+		t.Errorf("resources = %v, want 2 entries", result["resources"])
+	}
+}
+
+func TestWritePlanSummaryMarkdown(t *testing.T) {
+	var sb strings.Builder
+	if err := WritePlanSummaryMarkdown(&sb, "plan-abc123", testPlanSummary); err != nil {
+		t.Fatalf("WritePlanSummaryMarkdown() error = %v", err)
+	}
+	md := sb.String()
+
+	for _, want := range []string{
+		"**Changes:** 1 to update, 1 to delete",
+		"> [!WARNING]\n> This plan deletes 1 resource(s)",
+		"#### Update (1)",
+		"| `aws_db_instance.main` | instance_class, password (sensitive) |",
+		"#### Delete (1)",
+		"<details><summary>By provider</summary>",
+		"| hashicorp/random | 0 | 0 | 0 | 1 | 0 | 0 | 0 | 1 |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown is missing %q:\n%s", want, md)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Remote state consumer change statuses
+const (
+	RemoteStateConsumerAdd        = "Add"
+	RemoteStateConsumerRemove     = "Remove"
+	RemoteStateConsumerAdded      = "Added"
+	RemoteStateConsumerRemoved    = "Removed"
+	RemoteStateConsumerUnchanged  = "Unchanged"
+	RemoteStateConsumerFailed     = "Failed"
+	RemoteStateConsumerExists     = "Skipped: already a consumer"
+	RemoteStateConsumerNotFound   = "Skipped: not a consumer"
+	RemoteStateConsumerSelf       = "Skipped: same workspace"
+	RemoteStateGlobalSharingAlert = "Shares state with every workspace in the organization"
+)
+
+// RemoteStateConsumerChange is a change to a single consumer of a workspace's state
+type RemoteStateConsumerChange struct {
+	ConsumerName string `json:"consumerName"`
+	ConsumerID   string `json:"consumerId"`
+	Status       string `json:"status"`
+	Error        string `json:"error,omitempty"`
+}
+
+// Pending returns true if the change has been planned but not applied
+func (c RemoteStateConsumerChange) Pending() bool {
+	return c.Status == RemoteStateConsumerAdd || c.Status == RemoteStateConsumerRemove
+}
+
+// Failed returns true if applying the change returned an error
+func (c RemoteStateConsumerChange) Failed() bool {
+	return c.Error != ""
+}
+
+// RemoteStateReportEntry is the state sharing configuration of one workspace
+type RemoteStateReportEntry struct {
+	WorkspaceName     string `json:"workspaceName"`
+	WorkspaceID       string `json:"workspaceId"`
+	GlobalRemoteState bool   `json:"globalRemoteState"`
+	Consumers         int    `json:"consumers"`
+	Warning           string `json:"warning,omitempty"`
+}
+
+// RemoteStateShowView handles rendering for the remote-state show command
+type RemoteStateShowView struct {
+	*BaseView
+}
+
+func NewRemoteStateShowView() *RemoteStateShowView {
+	return &RemoteStateShowView{BaseView: NewBaseView()}
+}
+
+type remoteStateConsumer struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+type remoteStateShowOutput struct {
+	Name              string                `json:"name"`
+	ID                string                `json:"id"`
+	GlobalRemoteState bool                  `json:"globalRemoteState"`
+	Consumers         []remoteStateConsumer `json:"consumers"`
+}
+
+// Render renders the state sharing configuration of a workspace
+func (v *RemoteStateShowView) Render(workspace *tfe.Workspace, consumers []*tfe.Workspace) error {
+	if v.IsJSON() {
+		out := remoteStateShowOutput{
+			Name:              workspace.Name,
+			ID:                workspace.ID,
+			GlobalRemoteState: workspace.GlobalRemoteState,
+			Consumers:         []remoteStateConsumer{},
+		}
+		for _, c := range consumers {
+			out.Consumers = append(out.Consumers, remoteStateConsumer{Name: c.Name, ID: c.ID})
+		}
+		return v.Output().RenderJSON(out)
+	}
+
+	properties := []PropertyPair{
+		{Key: "Name", Value: workspace.Name},
+		{Key: "ID", Value: workspace.ID},
+		{Key: "Global State Sharing", Value: workspace.GlobalRemoteState},
+		{Key: "Consumers", Value: len(consumers)},
+	}
+	if err := v.Output().RenderProperties(properties); err != nil {
+		return err
+	}
+
+	if workspace.GlobalRemoteState {
+		v.Output().Message("")
+		v.Output().Message("Global state sharing is enabled, every workspace in the organization can read this state.")
+		return nil
+	}
+	if len(consumers) == 0 {
+		return nil
+	}
+
+	v.Output().Message("")
+	headers := []string{"Consumer", "ID"}
+	rows := make([][]interface{}, len(consumers))
+	for i, c := range consumers {
+		rows[i] = []interface{}{c.Name, c.ID}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// RemoteStateSetGlobalView handles rendering for the remote-state set-global command
+type RemoteStateSetGlobalView struct {
+	*BaseView
+}
+
+func NewRemoteStateSetGlobalView() *RemoteStateSetGlobalView {
+	return &RemoteStateSetGlobalView{BaseView: NewBaseView()}
+}
+
+type remoteStateSetGlobalOutput struct {
+	Name              string `json:"name"`
+	ID                string `json:"id"`
+	Previous          bool   `json:"previous"`
+	GlobalRemoteState bool   `json:"globalRemoteState"`
+}
+
+// Render renders the global state sharing setting before and after the change
+func (v *RemoteStateSetGlobalView) Render(workspace *tfe.Workspace, previous bool) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(remoteStateSetGlobalOutput{
+			Name:              workspace.Name,
+			ID:                workspace.ID,
+			Previous:          previous,
+			GlobalRemoteState: workspace.GlobalRemoteState,
+		})
+	}
+
+	properties := []PropertyPair{
+		{Key: "Name", Value: workspace.Name},
+		{Key: "ID", Value: workspace.ID},
+		{Key: "Previous", Value: previous},
+		{Key: "Global State Sharing", Value: workspace.GlobalRemoteState},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// RemoteStateChangeView handles rendering for the remote-state add-consumer, remove-consumer
+// and sync commands
+type RemoteStateChangeView struct {
+	*BaseView
+}
+
+func NewRemoteStateChangeView() *RemoteStateChangeView {
+	return &RemoteStateChangeView{BaseView: NewBaseView()}
+}
+
+type remoteStateChangeOutput struct {
+	Name              string                      `json:"name"`
+	ID                string                      `json:"id"`
+	GlobalRemoteState bool                        `json:"globalRemoteState"`
+	DryRun            bool                        `json:"dryRun"`
+	Changes           []RemoteStateConsumerChange `json:"changes"`
+}
+
+// Render renders the change for each consumer
+func (v *RemoteStateChangeView) Render(workspace *tfe.Workspace, changes []RemoteStateConsumerChange, dryRun bool) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(remoteStateChangeOutput{
+			Name:              workspace.Name,
+			ID:                workspace.ID,
+			GlobalRemoteState: workspace.GlobalRemoteState,
+			DryRun:            dryRun,
+			Changes:           changes,
+		})
+	}
+
+	headers := []string{"Consumer", "ID", "Status"}
+	rows := make([][]interface{}, len(changes))
+	for i, c := range changes {
+		rows[i] = []interface{}{c.ConsumerName, c.ConsumerID, statusWithError(c.Status, c.Error)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	if workspace.GlobalRemoteState {
+		v.Output().Message("Global state sharing is enabled, the consumer list has no effect until it is disabled.")
+	}
+	if dryRun {
+		v.Output().Message("Dry run, no changes were made.")
+	}
+	return nil
+}
+
+// RemoteStateReportView handles rendering for the remote-state report command
+type RemoteStateReportView struct {
+	*BaseView
+}
+
+func NewRemoteStateReportView() *RemoteStateReportView {
+	return &RemoteStateReportView{BaseView: NewBaseView()}
+}
+
+// Render renders the state sharing configuration of each workspace, flagging global sharing
+func (v *RemoteStateReportView) Render(entries []RemoteStateReportEntry) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(entries)
+	}
+
+	global := 0
+	headers := []string{"Workspace", "Global Sharing", "Consumers", "Warning"}
+	rows := make([][]interface{}, len(entries))
+	for i, e := range entries {
+		consumers := interface{}(e.Consumers)
+		if e.GlobalRemoteState {
+			consumers = "all"
+			global++
+		}
+		rows[i] = []interface{}{e.WorkspaceName, e.GlobalRemoteState, consumers, e.Warning}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	v.Output().Message("%d of %d workspace(s) share state globally", global, len(entries))
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+type RunApplyView struct{ *BaseView }
+
+func NewRunApplyView() *RunApplyView { return &RunApplyView{NewBaseView()} }
+
+// RenderSummary renders the plan of a run before asking for confirmation
+func (v *RunApplyView) RenderSummary(summary RunPlanSummary) error {
+	return renderPlanSummary(v.BaseView, summary)
+}
+
+func (v *RunApplyView) Render(runID string) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(map[string]string{"appliedRunId": runID})
+	}
+	props := []PropertyPair{{Key: "Applied run id", Value: runID}}
+	return v.Output().RenderProperties(props)
+}
+
+// RenderSkipped renders a run that was not confirmed
+func (v *RunApplyView) RenderSkipped(runID string) {
+	v.Output().Message("Skipped run '%s'", runID)
+}
+
+type RunCommentView struct{ *BaseView }
+
+func NewRunCommentView() *RunCommentView { return &RunCommentView{NewBaseView()} }
+
+func (v *RunCommentView) Render(runID string, comment *tfe.Comment) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(map[string]string{"runId": runID, "commentId": comment.ID, "body": comment.Body})
+	}
+	props := []PropertyPair{
+		{Key: "Run ID", Value: runID},
+		{Key: "Comment ID", Value: comment.ID},
+		{Key: "Body", Value: comment.Body},
+	}
+	return v.Output().RenderProperties(props)
+}
+
+// RunConfirmationListView handles rendering for run list --needs-confirmation
+type RunConfirmationListView struct{ *BaseView }
+
+func NewRunConfirmationListView() *RunConfirmationListView {
+	return &RunConfirmationListView{NewBaseView()}
+}
+
+type runConfirmationOutput struct {
+	ID        string `json:"id"`
+	Workspace string `json:"workspace"`
+	Status    string `json:"status"`
+	Created   string `json:"created"`
+	Message   string `json:"message"`
+}
+
+// Render renders runs waiting for confirmation across workspaces
+func (v *RunConfirmationListView) Render(runs []*tfe.Run) error {
+	out := make([]runConfirmationOutput, len(runs))
+	for i, r := range runs {
+		workspace := ""
+		if r.Workspace != nil {
+			workspace = r.Workspace.Name
+		}
+		out[i] = runConfirmationOutput{
+			ID:        r.ID,
+			Workspace: workspace,
+			Status:    string(r.Status),
+			Created:   FormatDateTime(r.CreatedAt),
+			Message:   r.Message,
+		}
+	}
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"Id", "Workspace", "Status", "Created", "Message"}
+	rows := make([][]interface{}, len(out))
+	for i, r := range out {
+		rows[i] = []interface{}{r.ID, r.Workspace, r.Status, r.Created, r.Message}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	v.Output().Message("%d run(s) need confirmation", len(runs))
+	return nil
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"github.com/straubt1/tfx/pkg/costestimate"
+)
+
+// RunCostResult is the cost estimate of a run with its resource breakdown
+type RunCostResult struct {
+	RunID                   string                  `json:"runId"`
+	CostEstimateID          string                  `json:"costEstimateId"`
+	Status                  string                  `json:"status"`
+	ErrorMessage            string                  `json:"errorMessage,omitempty"`
+	PriorMonthlyCost        float64                 `json:"priorMonthlyCost"`
+	ProposedMonthlyCost     float64                 `json:"proposedMonthlyCost"`
+	DeltaMonthlyCost        float64                 `json:"deltaMonthlyCost"`
+	ResourcesCount          int                     `json:"resourcesCount"`
+	MatchedResourcesCount   int                     `json:"matchedResourcesCount"`
+	UnmatchedResourcesCount int                     `json:"unmatchedResourcesCount"`
+	Resources               []costestimate.Resource `json:"resources"`
+}
+
+// RunCostView handles rendering for run cost command
+type RunCostView struct{ *BaseView }
+
+func NewRunCostView() *RunCostView { return &RunCostView{NewBaseView()} }
+
+func (v *RunCostView) Render(result *RunCostResult) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(result)
+	}
+
+	props := []PropertyPair{
+		{Key: "Run ID", Value: result.RunID},
+		{Key: "Cost Estimate ID", Value: result.CostEstimateID},
+		{Key: "Status", Value: result.Status},
+	}
+	if result.ErrorMessage != "" {
+		props = append(props, PropertyPair{Key: "Error", Value: result.ErrorMessage})
+	}
+	props = append(props,
+		PropertyPair{Key: "Prior Monthly Cost", Value: costestimate.FormatAmount(result.PriorMonthlyCost)},
+		PropertyPair{Key: "Proposed Monthly Cost", Value: costestimate.FormatAmount(result.ProposedMonthlyCost)},
+		PropertyPair{Key: "Delta", Value: costestimate.FormatDelta(result.DeltaMonthlyCost)},
+		PropertyPair{Key: "Resources", Value: result.ResourcesCount},
+		PropertyPair{Key: "Matched", Value: result.MatchedResourcesCount},
+		PropertyPair{Key: "Unmatched", Value: result.UnmatchedResourcesCount},
+	)
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+	if len(result.Resources) == 0 {
+		return nil
+	}
+
+	v.Output().Message("")
+	headers := []string{"Address", "Prior", "Proposed", "Delta"}
+	rows := make([][]interface{}, len(result.Resources))
+	for i, r := range result.Resources {
+		if !r.Matched {
+			rows[i] = []interface{}{r.Address, "-", "-", "not priced"}
+			continue
+		}
+		rows[i] = []interface{}{
+			r.Address,
+			costestimate.FormatAmount(r.PriorMonthlyCost),
+			costestimate.FormatAmount(r.ProposedMonthlyCost),
+			costestimate.FormatDelta(r.DeltaMonthlyCost),
+		}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
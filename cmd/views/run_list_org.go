@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// OrgRunListView handles rendering for the organization wide run list command
+type OrgRunListView struct{ *BaseView }
+
+func NewOrgRunListView() *OrgRunListView { return &OrgRunListView{NewBaseView()} }
+
+type orgRunListOutput struct {
+	ID          string `json:"id"`
+	Workspace   string `json:"workspace"`
+	Status      string `json:"status"`
+	Operation   string `json:"operation"`
+	Source      string `json:"source"`
+	TriggeredBy string `json:"triggeredBy"`
+	Created     string `json:"created"`
+	Message     string `json:"message"`
+}
+
+// Render renders runs across workspaces, newest first
+func (v *OrgRunListView) Render(runs []*tfe.Run) error {
+	out := make([]orgRunListOutput, len(runs))
+	for i, r := range runs {
+		workspace := ""
+		if r.Workspace != nil {
+			workspace = r.Workspace.Name
+		}
+		triggeredBy := ""
+		if r.CreatedBy != nil {
+			triggeredBy = r.CreatedBy.Username
+		}
+		out[i] = orgRunListOutput{
+			ID:          r.ID,
+			Workspace:   workspace,
+			Status:      string(r.Status),
+			Operation:   runOperation(r),
+			Source:      string(r.Source),
+			TriggeredBy: triggeredBy,
+			Created:     FormatDateTime(r.CreatedAt),
+			Message:     r.Message,
+		}
+	}
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"Id", "Workspace", "Status", "Operation", "Source", "Triggered By", "Created"}
+	rows := make([][]interface{}, len(out))
+	for i, r := range out {
+		rows[i] = []interface{}{r.ID, r.Workspace, r.Status, r.Operation, r.Source, r.TriggeredBy, r.Created}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	v.Output().Message("%d run(s) found", len(runs))
+	return nil
+}
+
+// runOperation returns the operation a run performs, named as accepted by --operation
+func runOperation(r *tfe.Run) string {
+	switch {
+	case r.IsDestroy:
+		return "destroy"
+	case r.RefreshOnly:
+		return "refresh-only"
+	case r.SavePlan:
+		return "save-plan"
+	case r.PlanOnly:
+		return "plan-only"
+	default:
+		return "plan-and-apply"
+	}
+}
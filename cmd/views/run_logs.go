@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+// RunLogsFile is a file written to a run log bundle
+type RunLogsFile struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+// RunLogsSkipped is a run output that was not written to the bundle and why
+type RunLogsSkipped struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+type RunLogsView struct{ *BaseView }
+
+func NewRunLogsView() *RunLogsView { return &RunLogsView{NewBaseView()} }
+
+type runLogsOutput struct {
+	RunID   string           `json:"runId"`
+	Output  string           `json:"output"`
+	Files   []RunLogsFile    `json:"files"`
+	Skipped []RunLogsSkipped `json:"skipped"`
+}
+
+func (v *RunLogsView) Render(runID string, path string, files []RunLogsFile, skipped []RunLogsSkipped) error {
+	if v.IsJSON() {
+		if skipped == nil {
+			skipped = []RunLogsSkipped{}
+		}
+		return v.Output().RenderJSON(runLogsOutput{
+			RunID:   runID,
+			Output:  path,
+			Files:   files,
+			Skipped: skipped,
+		})
+	}
+
+	props := []PropertyPair{
+		{Key: "Run ID", Value: runID},
+		{Key: "Output", Value: path},
+		{Key: "Files", Value: len(files)},
+	}
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+
+	rows := make([][]interface{}, len(files))
+	for i, f := range files {
+		rows[i] = []interface{}{f.Name, f.Size}
+	}
+	if err := v.Output().RenderTable([]string{"File", "Bytes"}, rows); err != nil {
+		return err
+	}
+
+	if len(skipped) > 0 {
+		v.Output().Message("Not included:")
+		rows = make([][]interface{}, len(skipped))
+		for i, s := range skipped {
+			rows[i] = []interface{}{s.Name, s.Reason}
+		}
+		return v.Output().RenderTable([]string{"Output", "Reason"}, rows)
+	}
+	return nil
+}
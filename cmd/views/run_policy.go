@@ -5,6 +5,7 @@ package view
 
 import (
 	"fmt"
+	"strings"
 )
 
 // RunPolicyResult holds all policy information for a run
@@ -18,6 +19,9 @@ type RunPolicyResult struct {
 	// Newer OPA/Sentinel policy evaluations (via task stages)
 	PolicyEvaluations []PolicyEvaluationDetail
 
+	// Results of run tasks, such as third-party scans, in the same task stages
+	TaskResults []TaskResultDetail
+
 	// True if any policy data exists
 	HasPolicies bool
 }
@@ -85,6 +89,7 @@ type runPolicyOutput struct {
 	HasPolicies       bool                     `json:"hasPolicies"`
 	PolicyChecks      []policyCheckOutput      `json:"policyChecks"`
 	PolicyEvaluations []policyEvaluationOutput `json:"policyEvaluations"`
+	TaskResults       []TaskResultDetail       `json:"taskResults"`
 }
 
 type policyCheckOutput struct {
@@ -141,6 +146,26 @@ func (v *RunPolicyView) Render(result *RunPolicyResult) error {
 	return v.renderTerminal(result)
 }
 
+// RenderJUnit prints the policy results as JUnit XML
+func (v *RunPolicyView) RenderJUnit(result *RunPolicyResult) error {
+	var sb strings.Builder
+	if err := WriteRunPolicyJUnit(&sb, result); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
+// RenderSARIF prints the policy results as a SARIF log
+func (v *RunPolicyView) RenderSARIF(result *RunPolicyResult) error {
+	var sb strings.Builder
+	if err := WriteRunPolicySARIF(&sb, result); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
 func (v *RunPolicyView) renderJSON(result *RunPolicyResult) error {
 	out := runPolicyOutput{
 		RunID:             result.RunID,
@@ -148,6 +173,10 @@ func (v *RunPolicyView) renderJSON(result *RunPolicyResult) error {
 		HasPolicies:       result.HasPolicies,
 		PolicyChecks:      make([]policyCheckOutput, len(result.PolicyChecks)),
 		PolicyEvaluations: make([]policyEvaluationOutput, len(result.PolicyEvaluations)),
+		TaskResults:       result.TaskResults,
+	}
+	if out.TaskResults == nil {
+		out.TaskResults = []TaskResultDetail{}
 	}
 
 	for i, pc := range result.PolicyChecks {
@@ -225,7 +254,7 @@ func (v *RunPolicyView) renderTerminal(result *RunPolicyResult) error {
 	if !result.HasPolicies {
 		v.Output().Message("")
 		v.Output().Message("No policy checks or evaluations found for this run.")
-		return nil
+		return v.renderTaskResults(result)
 	}
 
 	// Legacy Policy Checks (Sentinel)
@@ -310,5 +339,12 @@ func (v *RunPolicyView) renderTerminal(result *RunPolicyResult) error {
 		}
 	}
 
-	return nil
+	return v.renderTaskResults(result)
+}
+
+func (v *RunPolicyView) renderTaskResults(result *RunPolicyResult) error {
+	if len(result.TaskResults) == 0 {
+		return nil
+	}
+	return renderTaskResults(v.BaseView, result.TaskResults)
 }
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of policy results that can be overridden
+const (
+	PolicyOverridePolicyCheck = "policy-check"
+	PolicyOverrideTaskStage   = "task-stage"
+)
+
+// PolicyOverrideTarget is a legacy policy check or a task stage with policy evaluations
+// that was overridden
+type PolicyOverrideTarget struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	// Scope is the policy check scope or the task stage
+	Scope string `json:"scope"`
+}
+
+// RunPolicyOverrideResult is the outcome of overriding the policy failures of a run. Error is
+// set when the override stopped part way, after the comment was posted.
+type RunPolicyOverrideResult struct {
+	RunID      string                 `json:"runId"`
+	Overridden []PolicyOverrideTarget `json:"overridden"`
+	CommentID  string                 `json:"commentId"`
+	Comment    string                 `json:"comment"`
+	RunStatus  string                 `json:"runStatus"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// RunPolicyOverrideView handles rendering for run policy override command
+type RunPolicyOverrideView struct{ *BaseView }
+
+func NewRunPolicyOverrideView() *RunPolicyOverrideView {
+	return &RunPolicyOverrideView{NewBaseView()}
+}
+
+func (v *RunPolicyOverrideView) Render(result *RunPolicyOverrideResult) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(result)
+	}
+
+	overridden := make([]string, len(result.Overridden))
+	for i, t := range result.Overridden {
+		overridden[i] = fmt.Sprintf("%s (%s %s)", t.ID, strings.ReplaceAll(t.Kind, "-", " "), t.Scope)
+	}
+	props := []PropertyPair{
+		{Key: "Run ID", Value: result.RunID},
+		{Key: "Overridden", Value: strings.Join(overridden, ", ")},
+		{Key: "Comment ID", Value: result.CommentID},
+		{Key: "Comment", Value: result.Comment},
+		{Key: "Run Status", Value: result.RunStatus},
+	}
+	if result.Error != "" {
+		props = append(props, PropertyPair{Key: "Error", Value: result.Error})
+	}
+	return v.Output().RenderProperties(props)
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRunPolicyOverrideView_RenderPartial(t *testing.T) {
+	result := &RunPolicyOverrideResult{
+		RunID:      "run-1",
+		Overridden: []PolicyOverrideTarget{{Kind: PolicyOverridePolicyCheck, ID: "polchk-1", Scope: "organization"}},
+		CommentID:  "wsc-1",
+		Comment:    "Approved",
+		Error:      "failed to override policies: task stage 'ts-1' not found",
+	}
+
+	v := NewRunPolicyOverrideView()
+	out := captureOutput(t, func() error {
+		return v.Render(result)
+	})
+
+	var got RunPolicyOverrideResult
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if got.Error != result.Error || got.CommentID != "wsc-1" || len(got.Overridden) != 1 {
+		t.Errorf("Render() = %+v, want the partial result with its error", got)
+	}
+}
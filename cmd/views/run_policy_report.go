@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Policy test case statuses
+const (
+	PolicyCasePassed   = "passed"
+	PolicyCaseFailed   = "failed"
+	PolicyCaseAdvisory = "advisory"
+	PolicyCaseErrored  = "errored"
+)
+
+// PolicyTestCase is a single policy result, reported as a test case to CI systems. Legacy
+// Sentinel policy checks only report counts, so each check is a single case.
+type PolicyTestCase struct {
+	Suite            string
+	Name             string
+	EnforcementLevel string
+	Status           string
+	Description      string
+	Output           string
+	// HardMandatory is set for failures that can not be overridden
+	HardMandatory bool
+}
+
+// PolicyTestCases flattens the policy checks and evaluations of a run into test cases
+func PolicyTestCases(result *RunPolicyResult) []PolicyTestCase {
+	var cases []PolicyTestCase
+	for _, pc := range result.PolicyChecks {
+		c := PolicyTestCase{
+			Suite:  "Policy Check " + pc.ID,
+			Name:   fmt.Sprintf("sentinel (%s)", pc.Scope),
+			Status: PolicyCasePassed,
+			Output: pc.Logs,
+			Description: fmt.Sprintf("%d passed, %d hard failed, %d soft failed, %d advisory failed",
+				pc.Passed, pc.HardFailed, pc.SoftFailed, pc.AdvisoryFailed),
+		}
+		switch {
+		case pc.Status == "errored":
+			c.Status = PolicyCaseErrored
+		case pc.HardFailed > 0:
+			c.Status, c.EnforcementLevel, c.HardMandatory = PolicyCaseFailed, "hard-mandatory", true
+		case pc.SoftFailed > 0:
+			c.Status, c.EnforcementLevel = PolicyCaseFailed, "soft-mandatory"
+		case pc.AdvisoryFailed > 0:
+			c.Status, c.EnforcementLevel = PolicyCaseAdvisory, "advisory"
+		}
+		cases = append(cases, c)
+	}
+
+	for _, eval := range result.PolicyEvaluations {
+		for _, ps := range eval.PolicySets {
+			suite := ps.PolicySetName
+			if eval.Stage != "" {
+				suite = fmt.Sprintf("%s (%s)", ps.PolicySetName, eval.Stage)
+			}
+			if ps.Error != "" {
+				cases = append(cases, PolicyTestCase{Suite: suite, Name: ps.PolicySetName, Status: PolicyCaseErrored, Output: ps.Error})
+			}
+			for _, p := range ps.Policies {
+				c := PolicyTestCase{
+					Suite:            suite,
+					Name:             p.PolicyName,
+					EnforcementLevel: p.EnforcementLevel,
+					Status:           policyCaseStatus(p),
+					Description:      p.Description,
+					Output:           p.Output,
+				}
+				// OPA mandatory policies can only be overridden when the policy set allows it
+				c.HardMandatory = c.Status == PolicyCaseFailed &&
+					(p.EnforcementLevel == "hard-mandatory" || (p.EnforcementLevel == "mandatory" && !ps.Overridable))
+				cases = append(cases, c)
+			}
+		}
+	}
+	return cases
+}
+
+// HardMandatoryFailures returns the number of policies that failed and can not be overridden
+func HardMandatoryFailures(cases []PolicyTestCase) int {
+	failures := 0
+	for _, c := range cases {
+		if c.HardMandatory {
+			failures++
+		}
+	}
+	return failures
+}
+
+// ErroredPolicies returns the number of policies and policy sets that errored, which stop a
+// run the same way a failure that can not be overridden does
+func ErroredPolicies(cases []PolicyTestCase) int {
+	errored := 0
+	for _, c := range cases {
+		if c.Status == PolicyCaseErrored {
+			errored++
+		}
+	}
+	return errored
+}
+
+func policyCaseStatus(p PolicyDetail) string {
+	switch strings.ToLower(p.Status) {
+	case "passed":
+		return PolicyCasePassed
+	case "errored", "error":
+		return PolicyCaseErrored
+	}
+	if p.EnforcementLevel == "advisory" {
+		return PolicyCaseAdvisory
+	}
+	return PolicyCaseFailed
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitResult    `xml:"failure,omitempty"`
+	Error      *junitResult    `xml:"error,omitempty"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteRunPolicyJUnit writes the policy results of a run as JUnit XML, one test suite per
+// policy set or policy check and one test case per policy. Advisory failures pass.
+func WriteRunPolicyJUnit(w io.Writer, result *RunPolicyResult) error {
+	out := junitTestSuites{Name: result.RunID}
+	suites := map[string]int{}
+	for _, c := range PolicyTestCases(result) {
+		i, ok := suites[c.Suite]
+		if !ok {
+			i = len(out.Suites)
+			suites[c.Suite] = i
+			out.Suites = append(out.Suites, junitTestSuite{Name: c.Suite})
+		}
+		suite := &out.Suites[i]
+
+		tc := junitTestCase{
+			Name:      c.Name,
+			ClassName: c.Suite,
+			Properties: []junitProperty{
+				{Name: "enforcement_level", Value: c.EnforcementLevel},
+				{Name: "status", Value: c.Status},
+			},
+			SystemOut: c.Output,
+		}
+		if c.Description != "" {
+			tc.Properties = append(tc.Properties, junitProperty{Name: "description", Value: c.Description})
+		}
+		switch c.Status {
+		case PolicyCaseFailed:
+			tc.Failure = &junitResult{Message: fmt.Sprintf("%s policy failed", c.EnforcementLevel), Type: c.EnforcementLevel, Body: c.Output}
+			suite.Failures++
+			out.Failures++
+		case PolicyCaseErrored:
+			tc.Error = &junitResult{Message: "policy errored", Body: c.Output}
+			suite.Errors++
+			out.Errors++
+		}
+		suite.Cases = append(suite.Cases, tc)
+		suite.Tests++
+		out.Tests++
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	ShortDescription sarifMessage      `json:"shortDescription"`
+	Properties       map[string]string `json:"properties,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	Kind       string            `json:"kind"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+// WriteRunPolicySARIF writes the policy results of a run as a SARIF 2.1.0 log, with a rule per
+// policy and a result for each policy, passed or not
+func WriteRunPolicySARIF(w io.Writer, result *RunPolicyResult) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "tfx",
+			InformationURI: "https://github.com/straubt1/tfx",
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+
+	rules := map[string]bool{}
+	for _, c := range PolicyTestCases(result) {
+		ruleID := c.Suite + "/" + c.Name
+		if !rules[ruleID] {
+			rules[ruleID] = true
+			description := c.Description
+			if description == "" {
+				description = c.Name
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:               ruleID,
+				Name:             c.Name,
+				ShortDescription: sarifMessage{Text: description},
+				Properties:       map[string]string{"enforcementLevel": c.EnforcementLevel},
+			})
+		}
+
+		kind, level := "fail", "error"
+		switch {
+		case c.Status == PolicyCasePassed:
+			kind, level = "pass", "none"
+		case c.Status == PolicyCaseAdvisory:
+			level = "note"
+		case c.Status == PolicyCaseFailed && !c.HardMandatory:
+			level = "warning"
+		}
+		message := fmt.Sprintf("Policy %s %s", c.Name, c.Status)
+		if c.Output != "" {
+			message += ": " + strings.TrimSpace(c.Output)
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  ruleID,
+			Kind:    kind,
+			Level:   level,
+			Message: sarifMessage{Text: message},
+			Properties: map[string]string{
+				"runId":            result.RunID,
+				"enforcementLevel": c.EnforcementLevel,
+				"status":           c.Status,
+			},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	})
+}
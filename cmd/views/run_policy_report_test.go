@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var testRunPolicyResult = &RunPolicyResult{
+	RunID:     "run-abc123",
+	RunStatus: "post_plan_completed",
+	PolicyEvaluations: []PolicyEvaluationDetail{{
+		ID:         "poleval-1",
+		PolicyKind: "opa",
+		Stage:      "post_plan",
+		PolicySets: []PolicySetDetail{
+			{
+				PolicySetName: "security",
+				Policies: []PolicyDetail{
+					{PolicyName: "no-public-buckets", EnforcementLevel: "mandatory", Status: "failed", Output: "bucket logs is public"},
+					{PolicyName: "tags-required", EnforcementLevel: "advisory", Status: "failed"},
+					{PolicyName: "approved-regions", EnforcementLevel: "mandatory", Status: "passed"},
+				},
+			},
+			{
+				PolicySetName: "cost",
+				Overridable:   true,
+				Policies: []PolicyDetail{
+					{PolicyName: "instance-size", EnforcementLevel: "mandatory", Status: "failed"},
+				},
+			},
+		},
+	}},
+}
+
+func TestPolicyTestCases(t *testing.T) {
+	cases := PolicyTestCases(testRunPolicyResult)
+	if len(cases) != 4 {
+		t.Fatalf("got %d cases, want 4", len(cases))
+	}
+	want := []string{PolicyCaseFailed, PolicyCaseAdvisory, PolicyCasePassed, PolicyCaseFailed}
+	for i, c := range cases {
+		if c.Status != want[i] {
+			t.Errorf("%s status = %q, want %q", c.Name, c.Status, want[i])
+		}
+	}
+	// Only the mandatory failure in the policy set that can not be overridden counts
+	if got := HardMandatoryFailures(cases); got != 1 {
+		t.Errorf("HardMandatoryFailures() = %d, want 1", got)
+	}
+	if got := ErroredPolicies(cases); got != 0 {
+		t.Errorf("ErroredPolicies() = %d, want 0", got)
+	}
+	if got := ErroredPolicies(append(cases, PolicyTestCase{Status: PolicyCaseErrored})); got != 1 {
+		t.Errorf("ErroredPolicies() = %d, want 1", got)
+	}
+
+	legacy := PolicyTestCases(&RunPolicyResult{PolicyChecks: []PolicyCheckDetail{{ID: "polchk-1", Scope: "organization", SoftFailed: 1}}})
+	if len(legacy) != 1 || legacy[0].Status != PolicyCaseFailed || legacy[0].HardMandatory {
+		t.Errorf("legacy cases = %+v, want one soft-mandatory failure", legacy)
+	}
+}
+
+func TestWriteRunPolicyJUnit(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteRunPolicyJUnit(&sb, testRunPolicyResult); err != nil {
+		t.Fatalf("WriteRunPolicyJUnit() error = %v", err)
+	}
+	out := sb.String()
+
+	for _, want := range []string{
+		`<testsuites name="run-abc123" tests="4" failures="2" errors="0">`,
+		`<testsuite name="security (post_plan)" tests="3" failures="1" errors="0">`,
+		`<property name="enforcement_level" value="advisory"></property>`,
+		`<failure message="mandatory policy failed" type="mandatory">bucket logs is public</failure>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteRunPolicySARIF(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteRunPolicySARIF(&sb, testRunPolicyResult); err != nil {
+		t.Fatalf("WriteRunPolicySARIF() error = %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal([]byte(sb.String()), &log); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 4 {
+		t.Fatalf("got %+v, want one run with 4 results", log.Runs)
+	}
+	want := []string{"error", "note", "none", "warning"}
+	for i, r := range log.Runs[0].Results {
+		if r.Level != want[i] {
+			t.Errorf("%s level = %q, want %q", r.RuleID, r.Level, want[i])
+		}
+	}
+}
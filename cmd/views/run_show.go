@@ -12,17 +12,22 @@ type RunShowView struct{ *BaseView }
 func NewRunShowView() *RunShowView { return &RunShowView{NewBaseView()} }
 
 type runShowOutput struct {
-	ID                   string `json:"id"`
-	ConfigurationVersion string `json:"configurationVersion"`
-	PlanID               string `json:"planId"`
-	Status               string `json:"status"`
-	Message              string `json:"message"`
-	TerraformVersion     string `json:"terraformVersion"`
-	Created              string `json:"created"`
+	ID                   string             `json:"id"`
+	ConfigurationVersion string             `json:"configurationVersion"`
+	PlanID               string             `json:"planId"`
+	Status               string             `json:"status"`
+	Message              string             `json:"message"`
+	TerraformVersion     string             `json:"terraformVersion"`
+	Created              string             `json:"created"`
+	TaskResults          []TaskResultDetail `json:"taskResults"`
 }
 
-func (v *RunShowView) Render(run *tfe.Run) error {
+// Render renders a run and the results of its run tasks
+func (v *RunShowView) Render(run *tfe.Run, taskResults []TaskResultDetail) error {
 	if v.IsJSON() {
+		if taskResults == nil {
+			taskResults = []TaskResultDetail{}
+		}
 		cv := ""
 		if run.ConfigurationVersion != nil {
 			cv = run.ConfigurationVersion.ID
@@ -39,6 +44,7 @@ func (v *RunShowView) Render(run *tfe.Run) error {
 			Message:              run.Message,
 			TerraformVersion:     run.TerraformVersion,
 			Created:              FormatDateTime(run.CreatedAt),
+			TaskResults:          taskResults,
 		})
 	}
 
@@ -59,5 +65,11 @@ func (v *RunShowView) Render(run *tfe.Run) error {
 		{Key: "Terraform Version", Value: run.TerraformVersion},
 		{Key: "Created", Value: FormatDateTime(run.CreatedAt)},
 	}
-	return v.Output().RenderProperties(props)
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+	if len(taskResults) == 0 {
+		return nil
+	}
+	return renderTaskResults(v.BaseView, taskResults)
 }
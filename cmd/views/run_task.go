@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Run task attach and detach statuses
+const (
+	RunTaskAttached        = "Attached"
+	RunTaskUpdated         = "Updated"
+	RunTaskAlreadyAttached = "Already attached"
+	RunTaskDetached        = "Detached"
+	RunTaskNotAttached     = "Not attached"
+	RunTaskFailed          = "Failed"
+)
+
+// TaskResultDetail is the result of a run task, such as a third-party scan, in a task stage
+type TaskResultDetail struct {
+	ID               string `json:"id"`
+	Stage            string `json:"stage"`
+	TaskName         string `json:"taskName"`
+	EnforcementLevel string `json:"enforcementLevel"`
+	Status           string `json:"status"`
+	Message          string `json:"message,omitempty"`
+	URL              string `json:"url,omitempty"`
+}
+
+// NewTaskResultDetails converts the run task results of a run for rendering
+func NewTaskResultDetails(results []*tfe.TaskResult) []TaskResultDetail {
+	details := make([]TaskResultDetail, len(results))
+	for i, tr := range results {
+		details[i] = TaskResultDetail{
+			ID:               tr.ID,
+			TaskName:         tr.TaskName,
+			EnforcementLevel: string(tr.WorkspaceTaskEnforcementLevel),
+			Status:           string(tr.Status),
+			Message:          tr.Message,
+			URL:              tr.URL,
+		}
+		if tr.TaskStage != nil {
+			details[i].Stage = string(tr.TaskStage.Stage)
+		}
+	}
+	return details
+}
+
+// renderTaskResults renders a table of run task results under a heading
+func renderTaskResults(v *BaseView, results []TaskResultDetail) error {
+	v.Output().Message("")
+	v.Output().Message("=== Run Tasks ===")
+
+	headers := []string{"Task", "Stage", "Enforcement", "Status", "Message", "URL"}
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.TaskName, r.Stage, r.EnforcementLevel, r.Status, r.Message, r.URL}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// RunTaskListView handles rendering for the run-task list command
+type RunTaskListView struct{ *BaseView }
+
+func NewRunTaskListView() *RunTaskListView { return &RunTaskListView{NewBaseView()} }
+
+type runTaskOutput struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category"`
+	Enabled     bool   `json:"enabled"`
+	Workspaces  int    `json:"workspaces"`
+}
+
+func newRunTaskOutput(t *tfe.RunTask) runTaskOutput {
+	return runTaskOutput{
+		ID:          t.ID,
+		Name:        t.Name,
+		URL:         t.URL,
+		Description: t.Description,
+		Category:    t.Category,
+		Enabled:     t.Enabled,
+		Workspaces:  len(t.WorkspaceRunTasks),
+	}
+}
+
+// Render renders the run tasks of an organization with the number of workspaces using each
+func (v *RunTaskListView) Render(tasks []*tfe.RunTask) error {
+	out := make([]runTaskOutput, len(tasks))
+	for i, t := range tasks {
+		out[i] = newRunTaskOutput(t)
+	}
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"Name", "ID", "URL", "Enabled", "Workspaces"}
+	rows := make([][]interface{}, len(out))
+	for i, t := range out {
+		rows[i] = []interface{}{t.Name, t.ID, t.URL, t.Enabled, t.Workspaces}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// RunTaskCreateView handles rendering for the run-task create command
+type RunTaskCreateView struct{ *BaseView }
+
+func NewRunTaskCreateView() *RunTaskCreateView { return &RunTaskCreateView{NewBaseView()} }
+
+// Render renders a created run task, the HMAC key is write only and never rendered
+func (v *RunTaskCreateView) Render(task *tfe.RunTask) error {
+	out := newRunTaskOutput(task)
+	if v.IsJSON() {
+		return v.Output().RenderJSON(out)
+	}
+
+	props := []PropertyPair{
+		{Key: "ID", Value: out.ID},
+		{Key: "Name", Value: out.Name},
+		{Key: "URL", Value: out.URL},
+		{Key: "Description", Value: out.Description},
+		{Key: "Enabled", Value: out.Enabled},
+	}
+	return v.Output().RenderProperties(props)
+}
+
+// RunTaskAttachResult is the outcome of attaching a run task to, or detaching it from, one
+// workspace
+type RunTaskAttachResult struct {
+	WorkspaceName   string `json:"workspaceName"`
+	WorkspaceID     string `json:"workspaceId"`
+	WorkspaceTaskID string `json:"workspaceTaskId,omitempty"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+}
+
+// Failed returns true if attaching or detaching the run task returned an error
+func (r RunTaskAttachResult) Failed() bool {
+	return r.Error != ""
+}
+
+// RunTaskAttachView handles rendering for the run-task attach and detach commands
+type RunTaskAttachView struct{ *BaseView }
+
+func NewRunTaskAttachView() *RunTaskAttachView { return &RunTaskAttachView{NewBaseView()} }
+
+type runTaskAttachOutput struct {
+	TaskName string                `json:"taskName"`
+	Results  []RunTaskAttachResult `json:"results"`
+}
+
+// Render renders the result for each workspace
+func (v *RunTaskAttachView) Render(taskName string, results []RunTaskAttachResult) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(runTaskAttachOutput{TaskName: taskName, Results: results})
+	}
+
+	headers := []string{"Workspace", "Workspace Task ID", "Status"}
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.WorkspaceName, r.WorkspaceTaskID, statusWithError(r.Status, r.Error)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
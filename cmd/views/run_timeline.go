@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/pkg/runtimeline"
+)
+
+// timelineBarWidth is the width of the bar showing each phase's share of the run
+const timelineBarWidth = 20
+
+// RunTimelineView handles rendering for run timeline command
+type RunTimelineView struct{ *BaseView }
+
+func NewRunTimelineView() *RunTimelineView { return &RunTimelineView{NewBaseView()} }
+
+type runTimelinePhaseOutput struct {
+	Name            string  `json:"name"`
+	StartedAt       string  `json:"startedAt"`
+	FinishedAt      string  `json:"finishedAt"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	InProgress      bool    `json:"inProgress"`
+}
+
+type runTimelineOutput struct {
+	RunID           string                   `json:"runId"`
+	WorkspaceName   string                   `json:"workspaceName"`
+	Status          string                   `json:"status"`
+	CreatedAt       string                   `json:"createdAt"`
+	FinishedAt      string                   `json:"finishedAt"`
+	DurationSeconds float64                  `json:"durationSeconds"`
+	InProgress      bool                     `json:"inProgress"`
+	Phases          []runTimelinePhaseOutput `json:"phases"`
+}
+
+// Render renders each phase of a run with its duration and share of the total
+func (v *RunTimelineView) Render(run *tfe.Run, timeline runtimeline.Timeline) error {
+	workspaceName := ""
+	if run.Workspace != nil {
+		workspaceName = run.Workspace.Name
+	}
+
+	if v.IsJSON() {
+		out := runTimelineOutput{
+			RunID:           run.ID,
+			WorkspaceName:   workspaceName,
+			Status:          string(run.Status),
+			CreatedAt:       formatTimelineTime(timeline.CreatedAt, time.RFC3339),
+			FinishedAt:      formatTimelineTime(timeline.FinishedAt, time.RFC3339),
+			DurationSeconds: timeline.Duration.Seconds(),
+			InProgress:      timeline.InProgress,
+			Phases:          make([]runTimelinePhaseOutput, len(timeline.Phases)),
+		}
+		for i, p := range timeline.Phases {
+			out.Phases[i] = runTimelinePhaseOutput{
+				Name:            p.Name,
+				StartedAt:       formatTimelineTime(p.StartedAt, time.RFC3339),
+				FinishedAt:      formatTimelineTime(p.FinishedAt, time.RFC3339),
+				DurationSeconds: p.Duration.Seconds(),
+				InProgress:      p.InProgress,
+			}
+		}
+		return v.Output().RenderJSON(out)
+	}
+
+	duration := formatPhaseDuration(timeline.Duration)
+	if timeline.InProgress {
+		duration += " (in progress)"
+	}
+	props := []PropertyPair{
+		{Key: "Run ID", Value: run.ID},
+		{Key: "Workspace", Value: workspaceName},
+		{Key: "Status", Value: run.Status},
+		{Key: "Created", Value: formatTimelineTime(timeline.CreatedAt, time.DateTime)},
+		{Key: "Finished", Value: formatTimelineTime(timeline.FinishedAt, time.DateTime)},
+		{Key: "Duration", Value: duration},
+	}
+	if err := v.Output().RenderProperties(props); err != nil {
+		return err
+	}
+
+	headers := []string{"Phase", "Started", "Finished", "Duration", "Share"}
+	rows := make([][]interface{}, len(timeline.Phases))
+	for i, p := range timeline.Phases {
+		finished := formatTimelineTime(p.FinishedAt, time.TimeOnly)
+		if p.InProgress {
+			finished = "in progress"
+		}
+		rows[i] = []interface{}{
+			p.Name,
+			formatTimelineTime(p.StartedAt, time.TimeOnly),
+			finished,
+			formatPhaseDuration(p.Duration),
+			phaseShare(p.Duration, timeline.Duration),
+		}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// formatPhaseDuration formats a phase duration to the second, i.e. 4m32s
+func formatPhaseDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
+
+// phaseShare renders a bar and percentage of how much of the run a phase took
+func phaseShare(d time.Duration, total time.Duration) string {
+	if total <= 0 {
+		return ""
+	}
+	share := float64(d) / float64(total)
+	filled := min(int(share*timelineBarWidth+0.5), timelineBarWidth)
+	return fmt.Sprintf("%s%s %3.0f%%", strings.Repeat("█", filled), strings.Repeat("░", timelineBarWidth-filled), share*100)
+}
+
+// formatTimelineTime formats a timestamp in local time, or empty when it is not set
+func formatTimelineTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format(layout)
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Run trigger change statuses
+const (
+	RunTriggerAdded         = "Added"
+	RunTriggerRemoved       = "Removed"
+	RunTriggerAlreadyExists = "Skipped: already a source"
+	RunTriggerNotFound      = "Skipped: not a source"
+	RunTriggerSelf          = "Skipped: same workspace"
+)
+
+// RunTriggerListView handles rendering for the run-trigger list command
+type RunTriggerListView struct {
+	*BaseView
+}
+
+func NewRunTriggerListView() *RunTriggerListView {
+	return &RunTriggerListView{BaseView: NewBaseView()}
+}
+
+type runTriggerOutput struct {
+	ID        string `json:"id"`
+	Source    string `json:"source"`
+	Workspace string `json:"workspace"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// Render renders run triggers, each linking a source workspace to the workspace it queues runs in
+func (v *RunTriggerListView) Render(triggers []*tfe.RunTrigger) error {
+	if v.IsJSON() {
+		out := make([]runTriggerOutput, len(triggers))
+		for i, rt := range triggers {
+			out[i] = runTriggerOutput{
+				ID:        rt.ID,
+				Source:    rt.SourceableName,
+				Workspace: rt.WorkspaceName,
+				CreatedAt: FormatDateTime(rt.CreatedAt),
+			}
+		}
+		return v.Output().RenderJSON(out)
+	}
+
+	headers := []string{"ID", "Source", "Workspace", "Created"}
+	rows := make([][]interface{}, len(triggers))
+	for i, rt := range triggers {
+		rows[i] = []interface{}{rt.ID, rt.SourceableName, rt.WorkspaceName, FormatDateTime(rt.CreatedAt)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// RunTriggerResult is the outcome of adding or removing one source workspace
+type RunTriggerResult struct {
+	Source       string `json:"source"`
+	RunTriggerID string `json:"runTriggerId,omitempty"`
+	Status       string `json:"status"`
+}
+
+// RunTriggerChangeView handles rendering for the run-trigger add and remove commands
+type RunTriggerChangeView struct {
+	*BaseView
+}
+
+func NewRunTriggerChangeView() *RunTriggerChangeView {
+	return &RunTriggerChangeView{BaseView: NewBaseView()}
+}
+
+// Render renders the result for each source workspace
+func (v *RunTriggerChangeView) Render(results []RunTriggerResult) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(results)
+	}
+
+	headers := []string{"Source", "Run Trigger ID", "Status"}
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.Source, r.RunTriggerID, r.Status}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
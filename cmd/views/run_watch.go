@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"fmt"
+)
+
+// Run outcomes once a run has finished or is waiting for confirmation
+const (
+	RunOutcomeApplied            = "applied"
+	RunOutcomePlannedNoChanges   = "planned-no-changes"
+	RunOutcomePlannedWithChanges = "planned-with-changes"
+	RunOutcomeErrored            = "errored"
+	RunOutcomeCanceled           = "canceled"
+)
+
+// RunWatchSummary is the final state of a watched run
+type RunWatchSummary struct {
+	RunID         string `json:"runId"`
+	WorkspaceName string `json:"workspaceName"`
+	Status        string `json:"status"`
+	Outcome       string `json:"outcome"`
+	ExitCode      int    `json:"exitCode"`
+	HasChanges    bool   `json:"hasChanges"`
+	Additions     int    `json:"additions"`
+	Changes       int    `json:"changes"`
+	Destructions  int    `json:"destructions"`
+	Link          string `json:"link"`
+}
+
+// RunWatchView handles rendering for run watch and the --wait flag of run and plan create
+type RunWatchView struct{ *BaseView }
+
+func NewRunWatchView() *RunWatchView { return &RunWatchView{NewBaseView()} }
+
+// RenderLogLine renders a single line of plan or apply logs as it is streamed
+func (v *RunWatchView) RenderLogLine(line string) {
+	v.Output().Message("%s", line)
+}
+
+// Render renders the final status of a run
+func (v *RunWatchView) Render(summary RunWatchSummary) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(summary)
+	}
+
+	v.Output().Message("")
+	props := []PropertyPair{
+		{Key: "Run ID", Value: summary.RunID},
+		{Key: "Workspace", Value: summary.WorkspaceName},
+		{Key: "Status", Value: summary.Status},
+		{Key: "Outcome", Value: summary.Outcome},
+		{Key: "Resources", Value: formatResourceChanges(summary.Additions, summary.Changes, summary.Destructions)},
+		{Key: "Exit Code", Value: summary.ExitCode},
+		{Key: "Link", Value: summary.Link},
+	}
+	return v.Output().RenderProperties(props)
+}
+
+// formatResourceChanges formats plan counts the way Terraform summarizes a plan
+func formatResourceChanges(additions, changes, destructions int) string {
+	return fmt.Sprintf("%d to add, %d to change, %d to destroy", additions, changes, destructions)
+}
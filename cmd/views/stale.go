@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kinds of workspace activity
+const (
+	StaleActivityRun                  = "run"
+	StaleActivityStateVersion         = "state version"
+	StaleActivityConfigurationVersion = "configuration version"
+)
+
+// StaleWorkspace is a workspace with no activity within the report window
+type StaleWorkspace struct {
+	WorkspaceName    string     `json:"workspaceName"`
+	WorkspaceID      string     `json:"workspaceId"`
+	ProjectName      string     `json:"projectName,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	LastActivity     *time.Time `json:"lastActivity,omitempty"`
+	LastActivityType string     `json:"lastActivityType,omitempty"`
+	DaysInactive     int        `json:"daysInactive"`
+	ResourceCount    int        `json:"resourceCount"`
+	Locked           bool       `json:"locked"`
+	OwnerTeams       []string   `json:"ownerTeams"`
+}
+
+func formatLastActivity(s StaleWorkspace) string {
+	if s.LastActivity == nil {
+		return "never"
+	}
+	return FormatDateTime(*s.LastActivity) + " (" + s.LastActivityType + ")"
+}
+
+// StaleReportView handles rendering for the report stale command
+type StaleReportView struct {
+	*BaseView
+}
+
+func NewStaleReportView() *StaleReportView {
+	return &StaleReportView{BaseView: NewBaseView()}
+}
+
+// Render renders the stale workspaces, most inactive first
+func (v *StaleReportView) Render(stale []StaleWorkspace, scanned int) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(stale)
+	}
+
+	headers := []string{"Workspace", "Project", "Last Activity", "Days Inactive", "Resources", "Owner Teams", "Locked"}
+	rows := make([][]interface{}, len(stale))
+	for i, s := range stale {
+		rows[i] = []interface{}{s.WorkspaceName, s.ProjectName, formatLastActivity(s), s.DaysInactive, s.ResourceCount, strings.Join(s.OwnerTeams, ", "), s.Locked}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	v.Output().Message("%d of %d workspace(s) are stale", len(stale), scanned)
+	return nil
+}
+
+// RenderCSV writes the report as CSV to the terminal
+func (v *StaleReportView) RenderCSV(stale []StaleWorkspace) error {
+	var sb strings.Builder
+	if err := WriteStaleReportCSV(&sb, stale); err != nil {
+		return err
+	}
+	v.Output().Message("%s", strings.TrimSuffix(sb.String(), "\n"))
+	return nil
+}
+
+// RenderFile renders a summary of a report written to a file
+func (v *StaleReportView) RenderFile(stale []StaleWorkspace, scanned int, outputFile string) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(map[string]interface{}{
+			"outputFile": outputFile,
+			"workspaces": scanned,
+			"stale":      len(stale),
+		})
+	}
+
+	properties := []PropertyPair{
+		{Key: "Output File", Value: outputFile},
+		{Key: "Workspaces", Value: scanned},
+		{Key: "Stale", Value: len(stale)},
+	}
+	return v.Output().RenderProperties(properties)
+}
+
+// WriteStaleReportCSV writes one row per stale workspace, owner teams are separated by ';'
+func WriteStaleReportCSV(w io.Writer, stale []StaleWorkspace) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"workspace", "workspace_id", "project", "created_at", "last_activity", "last_activity_type", "days_inactive", "resource_count", "owner_teams", "locked"}); err != nil {
+		return err
+	}
+
+	for _, s := range stale {
+		lastActivity := ""
+		if s.LastActivity != nil {
+			lastActivity = s.LastActivity.UTC().Format(time.RFC3339)
+		}
+		record := []string{
+			s.WorkspaceName,
+			s.WorkspaceID,
+			s.ProjectName,
+			s.CreatedAt.UTC().Format(time.RFC3339),
+			lastActivity,
+			s.LastActivityType,
+			strconv.Itoa(s.DaysInactive),
+			strconv.Itoa(s.ResourceCount),
+			strings.Join(s.OwnerTeams, ";"),
+			strconv.FormatBool(s.Locked),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
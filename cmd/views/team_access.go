@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+// Team access change statuses
+const (
+	TeamAccessAdded         = "Added"
+	TeamAccessUpdated       = "Updated"
+	TeamAccessRemoved       = "Removed"
+	TeamAccessFailed        = "Failed"
+	TeamAccessAlreadyExists = "Skipped: team already has access"
+	TeamAccessNotFound      = "Skipped: team has no access"
+)
+
+// TeamAccessResult is the outcome of a team access change on one workspace
+type TeamAccessResult struct {
+	WorkspaceName string `json:"workspaceName"`
+	WorkspaceID   string `json:"workspaceId"`
+	TeamName      string `json:"teamName"`
+	Access        string `json:"access,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+// Failed returns true if the change returned an error
+func (r TeamAccessResult) Failed() bool {
+	return r.Error != ""
+}
+
+// TeamAccessChangeView handles rendering for the team add, update and remove commands
+type TeamAccessChangeView struct {
+	*BaseView
+}
+
+func NewTeamAccessChangeView() *TeamAccessChangeView {
+	return &TeamAccessChangeView{BaseView: NewBaseView()}
+}
+
+// Render renders the result of the change for each workspace
+func (v *TeamAccessChangeView) Render(results []TeamAccessResult) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(results)
+	}
+
+	headers := []string{"Workspace", "Team", "Access", "Status"}
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.WorkspaceName, r.TeamName, r.Access, statusWithError(r.Status, r.Error)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
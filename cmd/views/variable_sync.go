@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Variable sync statuses
+const (
+	VariableSyncCreate    = "Create"
+	VariableSyncUpdate    = "Update"
+	VariableSyncDelete    = "Delete"
+	VariableSyncUnchanged = "Unchanged"
+	VariableSyncCreated   = "Created"
+	VariableSyncUpdated   = "Updated"
+	VariableSyncDeleted   = "Deleted"
+	VariableSyncFailed    = "Failed"
+)
+
+// VariableSyncChange is a change to a single workspace variable. Value is the value from the
+// files, or the current value of a deleted variable, and is never rendered for sensitive
+// variables.
+type VariableSyncChange struct {
+	Key           string `json:"key"`
+	Category      string `json:"category"`
+	Value         string `json:"value,omitempty"`
+	PreviousValue string `json:"previousValue,omitempty"`
+	HCL           bool   `json:"hcl"`
+	Sensitive     bool   `json:"sensitive"`
+	VariableID    string `json:"variableId,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+// Pending returns true if the change has been planned but not applied
+func (c VariableSyncChange) Pending() bool {
+	return c.Status == VariableSyncCreate || c.Status == VariableSyncUpdate || c.Status == VariableSyncDelete
+}
+
+// Failed returns true if applying the change returned an error
+func (c VariableSyncChange) Failed() bool {
+	return c.Error != ""
+}
+
+// masked returns the change without its values when the variable is sensitive
+func (c VariableSyncChange) masked() VariableSyncChange {
+	if c.Sensitive {
+		c.Value, c.PreviousValue = "", ""
+	}
+	return c
+}
+
+// VariableSyncView handles rendering for the variable sync command
+type VariableSyncView struct {
+	*BaseView
+}
+
+func NewVariableSyncView() *VariableSyncView {
+	return &VariableSyncView{BaseView: NewBaseView()}
+}
+
+type variableSyncOutput struct {
+	WorkspaceName string               `json:"workspaceName"`
+	DryRun        bool                 `json:"dryRun"`
+	Changes       []VariableSyncChange `json:"changes"`
+}
+
+// Render renders the change for each variable followed by a count of each kind of change
+func (v *VariableSyncView) Render(workspaceName string, changes []VariableSyncChange, dryRun bool) error {
+	masked := make([]VariableSyncChange, len(changes))
+	for i, c := range changes {
+		masked[i] = c.masked()
+	}
+	if v.IsJSON() {
+		return v.Output().RenderJSON(variableSyncOutput{WorkspaceName: workspaceName, DryRun: dryRun, Changes: masked})
+	}
+
+	headers := []string{"Key", "Category", "HCL", "Value", "Status"}
+	rows := make([][]interface{}, len(masked))
+	for i, c := range masked {
+		rows[i] = []interface{}{c.Key, c.Category, c.HCL, formatSyncValue(c), statusWithError(c.Status, c.Error)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	v.Output().Message("%s", variableSyncSummary(changes))
+	if dryRun {
+		v.Output().Message("Dry run, no changes were made.")
+	}
+	return nil
+}
+
+// formatSyncValue shows the value of a variable, with the previous value of an update, each
+// on one line and limited to 20 characters for display
+func formatSyncValue(c VariableSyncChange) string {
+	if c.Sensitive {
+		return "(sensitive)"
+	}
+	value := truncateSyncValue(c.Value)
+	if c.PreviousValue != "" {
+		value = truncateSyncValue(c.PreviousValue) + " -> " + value
+	}
+	return value
+}
+
+func truncateSyncValue(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if len(value) > 20 {
+		return value[:20] + "..."
+	}
+	return value
+}
+
+func variableSyncSummary(changes []VariableSyncChange) string {
+	counts := map[string]int{}
+	for _, c := range changes {
+		switch c.Status {
+		case VariableSyncCreate, VariableSyncCreated:
+			counts[VariableSyncCreate]++
+		case VariableSyncUpdate, VariableSyncUpdated:
+			counts[VariableSyncUpdate]++
+		case VariableSyncDelete, VariableSyncDeleted:
+			counts[VariableSyncDelete]++
+		case VariableSyncUnchanged:
+			counts[VariableSyncUnchanged]++
+		}
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete, %d unchanged",
+		counts[VariableSyncCreate], counts[VariableSyncUpdate], counts[VariableSyncDelete], counts[VariableSyncUnchanged])
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestVariableSyncView_Render(t *testing.T) {
+	changes := []VariableSyncChange{
+		{Key: "region", Category: "terraform", Value: "us-west-2", PreviousValue: "us-east-1", VariableID: "var-1", Status: VariableSyncUpdated},
+		{Key: "db_password", Category: "terraform", Value: "hunter2", Sensitive: true, VariableID: "var-2", Status: VariableSyncUpdated},
+		{Key: "AWS_REGION", Category: "env", Value: "us-west-2", Status: VariableSyncCreated},
+	}
+
+	v := NewVariableSyncView()
+	out := captureOutput(t, func() error {
+		return v.Render("my-workspace", changes, false)
+	})
+
+	if strings.Contains(out, "hunter2") {
+		t.Fatalf("sensitive value was rendered:\n%s", out)
+	}
+
+	var result variableSyncOutput
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if result.WorkspaceName != "my-workspace" || len(result.Changes) != 3 {
+		t.Fatalf("result = %+v, want 3 changes for my-workspace", result)
+	}
+	if got := result.Changes[0]; got.Value != "us-west-2" || got.PreviousValue != "us-east-1" {
+		t.Errorf("region = %+v, want us-east-1 -> us-west-2", got)
+	}
+	if changes[1].Value != "hunter2" {
+		t.Errorf("Render() changed the value of the caller's change")
+	}
+}
+
+func TestVariableSyncSummary(t *testing.T) {
+	changes := []VariableSyncChange{
+		{Status: VariableSyncCreate},
+		{Status: VariableSyncCreated},
+		{Status: VariableSyncUpdate},
+		{Status: VariableSyncDeleted},
+		{Status: VariableSyncUnchanged},
+		{Status: VariableSyncFailed, Error: "permission denied"},
+	}
+	if got, want := variableSyncSummary(changes), "2 to create, 1 to update, 1 to delete, 1 unchanged"; got != want {
+		t.Errorf("variableSyncSummary() = %q, want %q", got, want)
+	}
+}
+
+func TestVariableSyncChange_Failed(t *testing.T) {
+	for _, status := range []string{VariableSyncCreate, VariableSyncUnchanged, VariableSyncCreated, VariableSyncDeleted, "Queued"} {
+		if (VariableSyncChange{Status: status}).Failed() {
+			t.Errorf("Failed() = true for %q", status)
+		}
+	}
+	if !(VariableSyncChange{Status: VariableSyncFailed, Error: "permission denied"}).Failed() {
+		t.Errorf("Failed() = false for a change with an error")
+	}
+}
@@ -50,6 +50,14 @@ func (v *BaseView) PrintCommandFilter(format string, args ...interface{}) {
 	v.out.MessageCommandFilter(format, args...)
 }
 
+// statusWithError returns the status of a result, followed by its error when it failed
+func statusWithError(status string, err string) string {
+	if err == "" {
+		return status
+	}
+	return status + ": " + err
+}
+
 // PropertyPair is re-exported from output package for convenience
 type PropertyPair = output.PropertyPair
 
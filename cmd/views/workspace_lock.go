@@ -3,9 +3,26 @@
 
 package view
 
+// Bulk lock and unlock statuses
+const (
+	WorkspaceLockLocked          = "Locked"
+	WorkspaceLockUnlocked        = "Unlocked"
+	WorkspaceLockAlreadyLocked   = "Already locked"
+	WorkspaceLockAlreadyUnlocked = "Already unlocked"
+	WorkspaceLockSkippedNotOurs  = "Skipped: was locked before the snapshot"
+	WorkspaceLockSkippedChanged  = "Skipped: now locked"
+	WorkspaceLockFailed          = "Failed"
+)
+
 type WorkspaceLockResult struct {
 	Name   string `json:"name"`
 	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Failed returns true if locking or unlocking the workspace returned an error
+func (r WorkspaceLockResult) Failed() bool {
+	return r.Error != ""
 }
 
 type WorkspaceLockView struct{ *BaseView }
@@ -20,14 +37,30 @@ func (v *WorkspaceLockView) RenderSingle(name, status string) error {
 	return v.Output().RenderProperties(props)
 }
 
-func (v *WorkspaceLockView) RenderBulk(results []WorkspaceLockResult) error {
+type workspaceLockBulkOutput struct {
+	SnapshotFile string                `json:"snapshotFile"`
+	Results      []WorkspaceLockResult `json:"results"`
+}
+
+// RenderBulk renders the result for each workspace. When a snapshot file was written or restored
+// it is reported after the results, and JSON output wraps the results in an object.
+func (v *WorkspaceLockView) RenderBulk(results []WorkspaceLockResult, snapshotFile string) error {
 	if v.IsJSON() {
+		if snapshotFile != "" {
+			return v.Output().RenderJSON(workspaceLockBulkOutput{SnapshotFile: snapshotFile, Results: results})
+		}
 		return v.Output().RenderJSON(results)
 	}
 	headers := []string{"Workspace", "Status"}
 	rows := make([][]interface{}, len(results))
 	for i, r := range results {
-		rows[i] = []interface{}{r.Name, r.Status}
+		rows[i] = []interface{}{r.Name, statusWithError(r.Status, r.Error)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	if snapshotFile != "" {
+		v.Output().Message("Snapshot file: %s", snapshotFile)
 	}
-	return v.Output().RenderTable(headers, rows)
+	return nil
 }
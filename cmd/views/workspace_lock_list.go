@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Lock holder types
+const (
+	LockHolderUser = "user"
+	LockHolderRun  = "run"
+	LockHolderTeam = "team"
+)
+
+// WorkspaceLockHolder describes who or what holds a workspace lock
+type WorkspaceLockHolder struct {
+	Type string `json:"type,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// LockHolder returns the holder of a workspace lock, requires the locked_by include
+func LockHolder(w *tfe.Workspace) WorkspaceLockHolder {
+	if w.LockedBy == nil {
+		return WorkspaceLockHolder{}
+	}
+
+	switch {
+	case w.LockedBy.Run != nil:
+		r := w.LockedBy.Run
+		return WorkspaceLockHolder{Type: LockHolderRun, ID: r.ID, Name: r.ID}
+	case w.LockedBy.User != nil:
+		u := w.LockedBy.User
+		name := u.Username
+		if name == "" {
+			name = u.ID
+		}
+		return WorkspaceLockHolder{Type: LockHolderUser, ID: u.ID, Name: name}
+	case w.LockedBy.Team != nil:
+		t := w.LockedBy.Team
+		name := t.Name
+		if name == "" {
+			name = t.ID
+		}
+		return WorkspaceLockHolder{Type: LockHolderTeam, ID: t.ID, Name: name}
+	}
+	return WorkspaceLockHolder{}
+}
+
+// workspaceLockOutput is a JSON-safe representation of a workspace lock
+type workspaceLockOutput struct {
+	Name     string               `json:"name"`
+	ID       string               `json:"id"`
+	Locked   bool                 `json:"locked"`
+	LockedBy *WorkspaceLockHolder `json:"lockedBy,omitempty"`
+	Reason   string               `json:"reason,omitempty"`
+}
+
+func newWorkspaceLockOutput(w *tfe.Workspace, reason string) workspaceLockOutput {
+	out := workspaceLockOutput{Name: w.Name, ID: w.ID, Locked: w.Locked}
+	if w.Locked {
+		holder := LockHolder(w)
+		out.LockedBy = &holder
+		out.Reason = reason
+	}
+	return out
+}
+
+// WorkspaceLockListView handles rendering for the workspace lock list command
+type WorkspaceLockListView struct {
+	*BaseView
+}
+
+func NewWorkspaceLockListView() *WorkspaceLockListView {
+	return &WorkspaceLockListView{BaseView: NewBaseView()}
+}
+
+// Render renders locked workspaces, their lock holders and the lock reasons keyed by workspace ID
+func (v *WorkspaceLockListView) Render(workspaces []*tfe.Workspace, reasons map[string]string) error {
+	if v.IsJSON() {
+		output := make([]workspaceLockOutput, len(workspaces))
+		for i, w := range workspaces {
+			output[i] = newWorkspaceLockOutput(w, reasons[w.ID])
+		}
+		return v.Output().RenderJSON(output)
+	}
+
+	headers := []string{"Name", "ID", "Locked By", "Holder", "Reason"}
+	rows := make([][]interface{}, len(workspaces))
+	for i, w := range workspaces {
+		holder := LockHolder(w)
+		rows[i] = []interface{}{w.Name, w.ID, holder.Type, holder.Name, reasons[w.ID]}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
+
+// WorkspaceLockShowView handles rendering for the workspace lock show command
+type WorkspaceLockShowView struct {
+	*BaseView
+}
+
+func NewWorkspaceLockShowView() *WorkspaceLockShowView {
+	return &WorkspaceLockShowView{BaseView: NewBaseView()}
+}
+
+// Render renders the lock state and lock reason of a single workspace
+func (v *WorkspaceLockShowView) Render(w *tfe.Workspace, reason string) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(newWorkspaceLockOutput(w, reason))
+	}
+
+	props := []PropertyPair{
+		{Key: "Name", Value: w.Name},
+		{Key: "ID", Value: w.ID},
+		{Key: "Locked", Value: w.Locked},
+	}
+	if w.Locked {
+		holder := LockHolder(w)
+		props = append(props,
+			PropertyPair{Key: "Locked By", Value: holder.Type},
+			PropertyPair{Key: "Holder", Value: holder.Name},
+			PropertyPair{Key: "Holder ID", Value: holder.ID},
+			PropertyPair{Key: "Reason", Value: reason},
+		)
+	}
+	return v.Output().RenderProperties(props)
+}
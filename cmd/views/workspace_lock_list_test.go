@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestWorkspaceLockListView_Render(t *testing.T) {
+	workspaces := []*tfe.Workspace{
+		{
+			Name: "ws-user", ID: "ws-1", Locked: true,
+			LockedBy: &tfe.LockedByChoice{User: &tfe.User{ID: "user-1", Username: "alice"}},
+		},
+		{
+			Name: "ws-run", ID: "ws-2", Locked: true,
+			LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-1", Message: "Triggered via UI"}},
+		},
+		{
+			Name: "ws-team", ID: "ws-3", Locked: true,
+			LockedBy: &tfe.LockedByChoice{Team: &tfe.Team{ID: "team-1"}},
+		},
+	}
+
+	v := NewWorkspaceLockListView()
+	out := captureOutput(t, func() error {
+		return v.Render(workspaces, map[string]string{"ws-1": "change freeze"})
+	})
+
+	var result []workspaceLockOutput
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if len(result) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(result))
+	}
+
+	user := result[0].LockedBy
+	if user == nil || user.Type != LockHolderUser || user.Name != "alice" || user.ID != "user-1" {
+		t.Errorf("user holder = %+v", user)
+	}
+	if result[0].Reason != "change freeze" {
+		t.Errorf("reason = %q, want %q", result[0].Reason, "change freeze")
+	}
+
+	run := result[1].LockedBy
+	if run == nil || run.Type != LockHolderRun || run.ID != "run-1" {
+		t.Errorf("run holder = %+v", run)
+	}
+	if result[1].Reason != "" {
+		t.Errorf("run message should not be reported as the lock reason, got %q", result[1].Reason)
+	}
+
+	team := result[2].LockedBy
+	if team == nil || team.Type != LockHolderTeam || team.Name != "team-1" {
+		t.Errorf("team holder without name should fall back to ID, got %+v", team)
+	}
+}
+
+func TestWorkspaceLockShowView_RenderUnlocked(t *testing.T) {
+	v := NewWorkspaceLockShowView()
+	out := captureOutput(t, func() error {
+		return v.Render(&tfe.Workspace{Name: "ws", ID: "ws-1"}, "")
+	})
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if result["locked"] != false {
+		t.Errorf("locked = %v, want false", result["locked"])
+	}
+	if _, ok := result["lockedBy"]; ok {
+		t.Errorf("expected lockedBy to be omitted for unlocked workspace")
+	}
+}
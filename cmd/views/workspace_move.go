@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+// Workspace move statuses
+const (
+	WorkspaceMovePending       = "Pending"
+	WorkspaceMoveMoved         = "Moved"
+	WorkspaceMoveFailed        = "Failed"
+	WorkspaceMoveAlreadyThere  = "Skipped: already in project"
+	WorkspaceMoveNoPermission  = "Denied: no permission to update workspace"
+	WorkspaceMoveMissingTarget = "Denied: target project not found"
+)
+
+// WorkspaceMove describes moving a single workspace between projects
+type WorkspaceMove struct {
+	WorkspaceName   string `json:"workspaceName"`
+	WorkspaceID     string `json:"workspaceId"`
+	FromProjectID   string `json:"fromProjectId"`
+	FromProjectName string `json:"fromProjectName"`
+	ToProjectID     string `json:"toProjectId"`
+	ToProjectName   string `json:"toProjectName"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+}
+
+// Movable returns true if the move passed preflight checks and still needs to be applied
+func (m WorkspaceMove) Movable() bool {
+	return m.Status == WorkspaceMovePending
+}
+
+// Denied returns true if the move failed a preflight check
+func (m WorkspaceMove) Denied() bool {
+	return m.Status == WorkspaceMoveNoPermission || m.Status == WorkspaceMoveMissingTarget
+}
+
+// Failed returns true if applying the move returned an error
+func (m WorkspaceMove) Failed() bool {
+	return m.Error != ""
+}
+
+type WorkspaceMoveView struct{ *BaseView }
+
+func NewWorkspaceMoveView() *WorkspaceMoveView { return &WorkspaceMoveView{NewBaseView()} }
+
+type workspaceMoveOutput struct {
+	DryRun       bool            `json:"dryRun"`
+	RollbackFile string          `json:"rollbackFile,omitempty"`
+	Moves        []WorkspaceMove `json:"moves"`
+}
+
+// RenderPlan renders the planned moves before they are applied (suppressed in JSON mode)
+func (v *WorkspaceMoveView) RenderPlan(moves []WorkspaceMove) error {
+	if v.IsJSON() {
+		return nil
+	}
+	v.Output().Message("Planned moves:")
+	return v.renderTable(moves)
+}
+
+// Render renders the final state of every move
+func (v *WorkspaceMoveView) Render(moves []WorkspaceMove, rollbackFile string, dryRun bool) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(workspaceMoveOutput{
+			DryRun:       dryRun,
+			RollbackFile: rollbackFile,
+			Moves:        moves,
+		})
+	}
+
+	if dryRun {
+		v.Output().Message("Dry run, no workspaces were moved.")
+		return nil
+	}
+
+	v.Output().Message("")
+	v.Output().Message("Results:")
+	if err := v.renderTable(moves); err != nil {
+		return err
+	}
+	if rollbackFile != "" {
+		v.Output().Message("Rollback file: %s", rollbackFile)
+		v.Output().Message("Undo with: tfx workspace move --from-file %s", rollbackFile)
+	}
+	return nil
+}
+
+func (v *WorkspaceMoveView) renderTable(moves []WorkspaceMove) error {
+	headers := []string{"Workspace", "From Project", "To Project", "Status"}
+	rows := make([][]interface{}, len(moves))
+	for i, m := range moves {
+		rows[i] = []interface{}{m.WorkspaceName, m.FromProjectName, m.ToProjectName, statusWithError(m.Status, m.Error)}
+	}
+	return v.Output().RenderTable(headers, rows)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import "testing"
+
+func TestWorkspaceMove_Failed(t *testing.T) {
+	tests := map[string]WorkspaceMove{
+		WorkspaceMovePending:       {Status: WorkspaceMovePending},
+		WorkspaceMoveMoved:         {Status: WorkspaceMoveMoved},
+		WorkspaceMoveAlreadyThere:  {Status: WorkspaceMoveAlreadyThere},
+		WorkspaceMoveNoPermission:  {Status: WorkspaceMoveNoPermission},
+		WorkspaceMoveMissingTarget: {Status: WorkspaceMoveMissingTarget},
+		"new status":               {Status: "Queued"},
+	}
+	for name, m := range tests {
+		if m.Failed() {
+			t.Errorf("Failed() with status %q = true, want false", name)
+		}
+	}
+	if !(WorkspaceMove{Status: WorkspaceMoveFailed, Error: "unauthorized"}).Failed() {
+		t.Errorf("Failed() with an error = false, want true")
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package view
+
+import (
+	"time"
+)
+
+// WorkspaceResource is a managed resource of a workspace and the run that last modified it
+type WorkspaceResource struct {
+	Address        string `json:"address"`
+	Type           string `json:"type"`
+	Provider       string `json:"provider"`
+	Module         string `json:"module"`
+	StateVersionID string `json:"stateVersionId,omitempty"`
+	RunID          string `json:"runId,omitempty"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+// WorkspaceResourceCount is the number of resources managed by a workspace. Billable is only
+// set when the resources were listed to leave out types that do not count towards RUM.
+type WorkspaceResourceCount struct {
+	WorkspaceName string `json:"workspaceName"`
+	WorkspaceID   string `json:"workspaceId"`
+	ProjectName   string `json:"projectName,omitempty"`
+	Resources     int    `json:"resources"`
+	Billable      *int   `json:"billable,omitempty"`
+}
+
+// formatAPITime formats an RFC3339 timestamp returned as a string by the API
+func formatAPITime(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return FormatDateTime(t)
+}
+
+// WorkspaceResourceListView handles rendering for the workspace resource list command
+type WorkspaceResourceListView struct {
+	*BaseView
+}
+
+func NewWorkspaceResourceListView() *WorkspaceResourceListView {
+	return &WorkspaceResourceListView{BaseView: NewBaseView()}
+}
+
+// Render renders the resources of a workspace
+func (v *WorkspaceResourceListView) Render(resources []WorkspaceResource) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(resources)
+	}
+
+	headers := []string{"Address", "Type", "Provider", "Module", "Last Run", "Updated"}
+	rows := make([][]interface{}, len(resources))
+	for i, r := range resources {
+		rows[i] = []interface{}{r.Address, r.Type, r.Provider, r.Module, r.RunID, formatAPITime(r.UpdatedAt)}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+	v.Output().Message("%d resource(s)", len(resources))
+	return nil
+}
+
+// WorkspaceResourceCountView handles rendering for the workspace resource count command
+type WorkspaceResourceCountView struct {
+	*BaseView
+}
+
+func NewWorkspaceResourceCountView() *WorkspaceResourceCountView {
+	return &WorkspaceResourceCountView{BaseView: NewBaseView()}
+}
+
+// Render renders the resource count of each workspace followed by the totals
+func (v *WorkspaceResourceCountView) Render(counts []WorkspaceResourceCount, billable bool) error {
+	if v.IsJSON() {
+		return v.Output().RenderJSON(counts)
+	}
+
+	headers := []string{"Workspace", "Project", "Resources"}
+	if billable {
+		headers = append(headers, "Billable")
+	}
+
+	total, totalBillable := 0, 0
+	rows := make([][]interface{}, len(counts))
+	for i, c := range counts {
+		total += c.Resources
+		rows[i] = []interface{}{c.WorkspaceName, c.ProjectName, c.Resources}
+		if billable && c.Billable != nil {
+			totalBillable += *c.Billable
+			rows[i] = append(rows[i], *c.Billable)
+		}
+	}
+	if err := v.Output().RenderTable(headers, rows); err != nil {
+		return err
+	}
+
+	v.Output().Message("Total: %d resource(s) across %d workspace(s)", total, len(counts))
+	if billable {
+		v.Output().Message("Billable: %d resource(s) under management", totalBillable)
+	}
+	return nil
+}
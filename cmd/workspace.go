@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"strings"
+
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -182,3 +184,70 @@ func workspaceShow(cmdConfig *flags.WorkspaceShowFlags) error {
 
 	return v.Render(c.OrganizationName, workspace, currentRun, teamNames, remoteStateConsumers)
 }
+
+// addWorkspaceSelectorFlags registers the shared flags used by bulk commands to select workspaces.
+// projectFlag names the project filter flag so commands that target a project can rename it.
+func addWorkspaceSelectorFlags(cmd *cobra.Command, projectFlag string) {
+	cmd.Flags().StringSliceP("name", "n", []string{}, "Workspace name, can be supplied multiple times or comma separated (optional).")
+	cmd.Flags().StringP("search", "s", "", "Search string anywhere in the Workspace Name (optional).")
+	cmd.Flags().StringP("wildcard-name", "w", "", "Wildcard search string for Workspace Name, Examples: *-prod or prod-* (optional).")
+	cmd.Flags().String("tags", "", "Filter on Workspaces with this tag (optional).")
+	cmd.Flags().String("exclude-tags", "", "Filter out Workspaces with this tag (optional).")
+	cmd.Flags().String(projectFlag, "", "Filter on Workspaces in this Project (optional).")
+}
+
+func workspaceSelectorFromFlags(f flags.WorkspaceSelectorFlags) data.WorkspaceSelector {
+	return data.WorkspaceSelector{
+		Names:        f.Names,
+		Search:       f.Search,
+		WildcardName: f.WildcardName,
+		Tags:         f.Tags,
+		ExcludeTags:  f.ExcludeTags,
+		ProjectName:  f.ProjectName,
+	}
+}
+
+// printWorkspaceSelector displays which workspace selector flags are set
+func printWorkspaceSelector(v *view.BaseView, f flags.WorkspaceSelectorFlags) {
+	printSelectorFilters(v, f, "")
+}
+
+// printSelectorFilters displays which selector flags are set, labelled with the flag prefix
+func printSelectorFilters(v *view.BaseView, f flags.WorkspaceSelectorFlags, prefix string) {
+	printFilterList(v, selectorFilters(f, prefix))
+}
+
+// selectorFilters describes the selector flags that are set, labelled with the flag prefix
+func selectorFilters(f flags.WorkspaceSelectorFlags, prefix string) []string {
+	var filtersSet []string
+	if len(f.Names) > 0 {
+		filtersSet = append(filtersSet, prefix+"name: "+strings.Join(f.Names, ", "))
+	}
+	if f.ProjectName != "" {
+		filtersSet = append(filtersSet, prefix+"project: "+f.ProjectName)
+	}
+	if f.Tags != "" {
+		filtersSet = append(filtersSet, prefix+"tags: "+f.Tags)
+	}
+	if f.ExcludeTags != "" {
+		filtersSet = append(filtersSet, prefix+"exclude-tags: "+f.ExcludeTags)
+	}
+	if f.Search != "" {
+		filtersSet = append(filtersSet, prefix+"search: "+f.Search)
+	}
+	if f.WildcardName != "" {
+		filtersSet = append(filtersSet, prefix+"wildcard-name: "+f.WildcardName)
+	}
+
+	return filtersSet
+}
+
+// printFilterList displays a list of the filters that are set
+func printFilterList(v *view.BaseView, filtersSet []string) {
+	if len(filtersSet) > 0 {
+		v.PrintCommandFilter("Active filters:")
+		for _, filter := range filtersSet {
+			v.PrintCommandFilter("  - %s", filter)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+	"github.com/straubt1/tfx/pkg/runlog"
+)
+
+var (
+	// `tfx workspace apply` commands
+	applyCmd = &cobra.Command{
+		Use:   "apply",
+		Short: "Applies",
+		Long:  "Work with Applies of a TFx Workspace.",
+	}
+
+	// `tfx workspace apply show` command
+	applyShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show Apply",
+		Long:  "Show Apply details for a TFx Workspace.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseApplyShowFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return applyShow(cmdConfig)
+		},
+	}
+
+	// `tfx workspace apply logs` command
+	applyLogsCmd = &cobra.Command{
+		Use:   "logs",
+		Short: "Show Apply Logs",
+		Long: `Show Apply logs for a TFx Workspace.
+Use --diagnostics to only show errors and warnings, read from structured run output or from
+Terraform's error and warning blocks, and --format github to write them as GitHub Actions annotations.`,
+		Example: `
+tfx workspace apply logs --id apply-5zXbW8qkPnRfYc2T --diagnostics
+
+Annotate a pull request from a GitHub Actions job:
+tfx workspace apply logs --id apply-5zXbW8qkPnRfYc2T --format github`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseApplyLogsFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return applyLogs(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace apply show` command
+	applyShowCmd.Flags().StringP("id", "i", "", "Apply Id (i.e. apply-*)")
+	applyShowCmd.MarkFlagRequired("id")
+
+	// `tfx workspace apply logs` command
+	applyLogsCmd.Flags().StringP("id", "i", "", "Apply Id (i.e. apply-*)")
+	applyLogsCmd.Flags().Bool("diagnostics", false, "Only show errors and warnings, with the file and line they refer to (optional)")
+	applyLogsCmd.Flags().String("format", "text", fmt.Sprintf("Output format (optional, one of: %s). github writes diagnostics as GitHub Actions annotations.", strings.Join(flags.LogFormats, ", ")))
+	applyLogsCmd.MarkFlagRequired("id")
+
+	workspaceCmd.AddCommand(applyCmd)
+	applyCmd.AddCommand(applyShowCmd)
+	applyCmd.AddCommand(applyLogsCmd)
+}
+
+func applyShow(cmdConfig *flags.ApplyShowFlags) error {
+	v := view.NewApplyShowView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing apply '%s'", cmdConfig.ID)
+
+	apply, err := data.FetchApply(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read apply from id"))
+	}
+
+	return v.Render(apply)
+}
+
+func applyLogs(cmdConfig *flags.ApplyLogsFlags) error {
+	v := view.NewApplyLogsView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// Annotations are read by GitHub Actions from stdout, so only print a header for text
+	if cmdConfig.Format == "text" {
+		v.PrintCommandHeader("Showing logs for apply '%s'", cmdConfig.ID)
+	}
+
+	logs, err := data.FetchApplyLogs(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read apply logs"))
+	}
+	if !cmdConfig.Diagnostics {
+		return v.Render(logs)
+	}
+
+	diagnostics := runlog.Diagnostics(runlog.Parse(logs))
+	if cmdConfig.Format == "github" {
+		return v.RenderGitHubAnnotations(diagnostics)
+	}
+	return v.RenderDiagnostics(diagnostics)
+}
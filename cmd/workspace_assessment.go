@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace assessment` commands
+	workspaceAssessmentCmd = &cobra.Command{
+		Use:   "assessment",
+		Short: "Health Assessment Commands",
+		Long:  "Work with the health assessments (drift detection and continuous validation) of a Workspace.",
+	}
+
+	// `tfx workspace assessment show` command
+	workspaceAssessmentShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show the current health assessment",
+		Long: `Show the current health assessment of a Workspace, including the resources that drifted and
+the checks that failed. Listing drifted resources and failed checks requires admin access to the Workspace.`,
+		Example: `
+tfx workspace assessment show --name app-prod
+
+Fail when the workspace drifted or has failing checks:
+tfx workspace assessment show --name app-prod --fail-on-drift`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseAssessmentShowFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceAssessmentShow(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace assessment show`
+	workspaceAssessmentShowCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceAssessmentShowCmd.Flags().Bool("fail-on-drift", false, "Exit with a non-zero code when the Workspace drifted or has failing checks (optional).")
+	workspaceAssessmentShowCmd.MarkFlagRequired("name")
+
+	workspaceCmd.AddCommand(workspaceAssessmentCmd)
+	workspaceAssessmentCmd.AddCommand(workspaceAssessmentShowCmd)
+}
+
+func workspaceAssessmentShow(cmdConfig *flags.AssessmentShowFlags) error {
+	v := view.NewAssessmentShowView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing assessment for workspace '%s' in organization '%s'", cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	assessment, err := data.FetchWorkspaceAssessment(c, workspace, true)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read assessment"))
+	}
+
+	if err := v.Render(assessment); err != nil {
+		return err
+	}
+	if cmdConfig.FailOnDrift && assessment.HasFindings() {
+		return fmt.Errorf("workspace '%s' assessment status: %s", workspace.Name, assessment.Status)
+	}
+	return nil
+}
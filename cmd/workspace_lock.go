@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/straubt1/tfx/client"
@@ -30,7 +32,16 @@ var (
 	workspaceLockAllCmd = &cobra.Command{
 		Use:   "all",
 		Short: "Lock All Workspaces",
-		Long:  "Lock All Workspaces in a TFx Organization.",
+		Long: `Lock All Workspaces in a TFx Organization, or the Workspaces matching the filter flags.
+
+Use --snapshot to record which Workspaces were already locked and by whom, so that
+'tfx workspace unlock all --restore' only releases the locks taken by this command.`,
+		Example: `
+Lock every workspace for a change freeze:
+tfx workspace lock all --snapshot freeze.json --reason "Quarter end change freeze"
+
+Lock the workspaces in a project with a tag:
+tfx workspace lock all --project-name platform --tags env:prod --snapshot freeze.json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParseWorkspaceLockAllFlags(cmd)
 			if err != nil {
@@ -40,6 +51,42 @@ var (
 		},
 	}
 
+	// `tfx workspace lock list` command
+	workspaceLockListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List locked Workspaces",
+		Long:  "List locked Workspaces in a TFx Organization, with the user, run or team holding each lock.",
+		Example: `
+List every locked workspace:
+tfx workspace lock list
+
+List locked workspaces with a tag:
+tfx workspace lock list --tags env:prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseWorkspaceLockListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceLockList(cmdConfig)
+		},
+	}
+
+	// `tfx workspace lock show` command
+	workspaceLockShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show Workspace lock details",
+		Long:  "Show the lock state of a Workspace, with the user, run or team holding the lock.",
+		Example: `
+tfx workspace lock show --name my-workspace`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseWorkspaceLockShowFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceLockShow(cmdConfig)
+		},
+	}
+
 	// `tfx workspace unlock` command
 	workspaceUnlockCmd = &cobra.Command{
 		Use:   "unlock",
@@ -58,7 +105,16 @@ var (
 	workspaceUnlockAllCmd = &cobra.Command{
 		Use:   "all",
 		Short: "Unlock All Workspaces",
-		Long:  "Unlock All Workspaces in a TFx Organization.",
+		Long: `Unlock All Workspaces in a TFx Organization, or the Workspaces matching the filter flags.
+
+Without --restore every matching lock is force unlocked, regardless of who holds it.
+With --restore only the Workspaces locked by 'tfx workspace lock all --snapshot' are unlocked.`,
+		Example: `
+Release the locks taken for a change freeze:
+tfx workspace unlock all --restore freeze.json
+
+Release the freeze for one project only:
+tfx workspace unlock all --restore freeze.json --project-name platform`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParseWorkspaceUnlockAllFlags(cmd)
 			if err != nil {
@@ -75,17 +131,33 @@ func init() {
 	workspaceLockCmd.MarkFlagRequired("name")
 
 	// `tfx workspace lock all`
-	workspaceLockAllCmd.Flags().StringP("search", "s", "", "Search string for Workspace Name (optional).")
+	addWorkspaceSelectorFlags(workspaceLockAllCmd, "project-name")
+	workspaceLockAllCmd.Flags().String("reason", "", "Reason recorded on each lock (optional, defaults to \"Locked via TFx\").")
+	workspaceLockAllCmd.Flags().String("snapshot", "", "Write a snapshot file recording which Workspaces were locked by this command (optional).")
+
+	// `tfx workspace lock list`
+	workspaceLockListCmd.Flags().StringP("search", "s", "", "Search string anywhere in the Workspace Name (optional).")
+	workspaceLockListCmd.Flags().StringP("wildcard-name", "w", "", "Wildcard search string for Workspace Name, Examples: *-prod or prod-* (optional).")
+	workspaceLockListCmd.Flags().String("project-id", "", "Filter on Workspaces in this Project (optional).")
+	workspaceLockListCmd.Flags().String("tags", "", "Filter on Workspaces with this tag (optional).")
+	workspaceLockListCmd.Flags().String("exclude-tags", "", "Filter out Workspaces with this tag (optional).")
+
+	// `tfx workspace lock show`
+	workspaceLockShowCmd.Flags().StringP("name", "n", "", "Workspace name")
+	workspaceLockShowCmd.MarkFlagRequired("name")
 
 	// `tfx workspace unlock`
 	workspaceUnlockCmd.Flags().StringP("name", "n", "", "Workspace name")
 	workspaceUnlockCmd.MarkFlagRequired("name")
 
 	// `tfx workspace unlock all`
-	workspaceUnlockAllCmd.Flags().StringP("search", "s", "", "Search string for Workspace Name (optional).")
+	addWorkspaceSelectorFlags(workspaceUnlockAllCmd, "project-name")
+	workspaceUnlockAllCmd.Flags().String("restore", "", "Only unlock the Workspaces locked by a previous lock all --snapshot, using this snapshot file (optional).")
 
 	workspaceCmd.AddCommand(workspaceLockCmd)
 	workspaceLockCmd.AddCommand(workspaceLockAllCmd)
+	workspaceLockCmd.AddCommand(workspaceLockListCmd)
+	workspaceLockCmd.AddCommand(workspaceLockShowCmd)
 	workspaceCmd.AddCommand(workspaceUnlockCmd)
 	workspaceUnlockCmd.AddCommand(workspaceUnlockAllCmd)
 }
@@ -117,27 +189,107 @@ func workspaceLockAll(cmdConfig *flags.WorkspaceLockAllFlags) error {
 	}
 
 	v.PrintCommandHeader("Locking workspaces in organization '%s'", c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	reason := cmdConfig.Reason
+	if reason == "" {
+		reason = data.DefaultLockReason
+	}
+
+	// Write the snapshot before locking anything so a freeze can always be lifted, then rewrite
+	// it with the locks that were actually taken
+	if cmdConfig.Snapshot != "" {
+		initial := data.NewWorkspaceLockSnapshot(c.OrganizationName, workspaces, reason)
+		if err := data.WriteWorkspaceLockSnapshot(cmdConfig.Snapshot, initial); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to write snapshot file, no workspaces were locked"))
+		}
+	}
+
+	results, snapshot := data.LockWorkspaces(c, c.OrganizationName, workspaces, reason, data.DefaultParallelism)
+
+	if cmdConfig.Snapshot != "" {
+		if err := data.WriteWorkspaceLockSnapshot(cmdConfig.Snapshot, snapshot); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to write snapshot file"))
+		}
+	}
+
+	return renderWorkspaceLockResults(v, results, cmdConfig.Snapshot)
+}
+
+func workspaceLockList(cmdConfig *flags.WorkspaceLockListFlags) error {
+	v := view.NewWorkspaceLockListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing locked workspaces in organization '%s'", c.OrganizationName)
 	if cmdConfig.Search != "" {
 		v.PrintCommandFilter("search: %s", cmdConfig.Search)
 	}
+	if cmdConfig.WildcardName != "" {
+		v.PrintCommandFilter("wildcard-name: %s", cmdConfig.WildcardName)
+	}
+	if cmdConfig.ProjectID != "" {
+		v.PrintCommandFilter("project-id: %s", cmdConfig.ProjectID)
+	}
+	if cmdConfig.Tags != "" {
+		v.PrintCommandFilter("tags: %s", cmdConfig.Tags)
+	}
+	if cmdConfig.ExcludeTags != "" {
+		v.PrintCommandFilter("exclude-tags: %s", cmdConfig.ExcludeTags)
+	}
 
-	opts := &flags.WorkspaceListFlags{Search: cmdConfig.Search}
-	workspaces, err := data.FetchWorkspaces(c, c.OrganizationName, opts)
+	opts := &flags.WorkspaceListFlags{
+		Search:       cmdConfig.Search,
+		WildcardName: cmdConfig.WildcardName,
+		ProjectID:    cmdConfig.ProjectID,
+		Tags:         cmdConfig.Tags,
+		ExcludeTags:  cmdConfig.ExcludeTags,
+	}
+	workspaces, err := data.FetchLockedWorkspaces(c, c.OrganizationName, opts)
 	if err != nil {
 		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
 	}
 
-	results := make([]view.WorkspaceLockResult, 0, len(workspaces))
-	for _, ws := range workspaces {
-		status, err := data.SetWorkspaceLock(c, c.OrganizationName, ws.Name, true)
+	reasons, err := data.FetchWorkspaceLockReasons(c, workspaces)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read lock reasons"))
+	}
+
+	return v.Render(workspaces, reasons)
+}
+
+func workspaceLockShow(cmdConfig *flags.WorkspaceLockShowFlags) error {
+	v := view.NewWorkspaceLockShowView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing lock for workspace '%s'", cmdConfig.Name)
+
+	workspace, err := data.FetchWorkspaceWithLock(c, c.OrganizationName, cmdConfig.Name)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	var reason string
+	if workspace.Locked {
+		reason, err = data.FetchWorkspaceLockReason(c, workspace.ID)
 		if err != nil {
-			results = append(results, view.WorkspaceLockResult{Name: ws.Name, Status: err.Error()})
-		} else {
-			results = append(results, view.WorkspaceLockResult{Name: ws.Name, Status: status})
+			return v.RenderError(errors.Wrap(err, "failed to read lock reason"))
 		}
 	}
 
-	return v.RenderBulk(results)
+	return v.Render(workspace, reason)
 }
 
 func workspaceUnlock(cmdConfig *flags.WorkspaceUnlockFlags) error {
@@ -166,13 +318,14 @@ func workspaceUnlockAll(cmdConfig *flags.WorkspaceUnlockAllFlags) error {
 		return v.RenderError(err)
 	}
 
-	v.PrintCommandHeader("Unlocking workspaces in organization '%s'", c.OrganizationName)
-	if cmdConfig.Search != "" {
-		v.PrintCommandFilter("search: %s", cmdConfig.Search)
+	if cmdConfig.Restore != "" {
+		return workspaceUnlockAllRestore(v, c, cmdConfig)
 	}
 
-	opts := &flags.WorkspaceListFlags{Search: cmdConfig.Search}
-	workspaces, err := data.FetchWorkspaces(c, c.OrganizationName, opts)
+	v.PrintCommandHeader("Unlocking workspaces in organization '%s'", c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
 	if err != nil {
 		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
 	}
@@ -181,11 +334,56 @@ func workspaceUnlockAll(cmdConfig *flags.WorkspaceUnlockAllFlags) error {
 	for _, ws := range workspaces {
 		status, err := data.SetWorkspaceLock(c, c.OrganizationName, ws.Name, false)
 		if err != nil {
-			results = append(results, view.WorkspaceLockResult{Name: ws.Name, Status: err.Error()})
+			results = append(results, view.WorkspaceLockResult{Name: ws.Name, Status: view.WorkspaceLockFailed, Error: err.Error()})
 		} else {
 			results = append(results, view.WorkspaceLockResult{Name: ws.Name, Status: status})
 		}
 	}
 
-	return v.RenderBulk(results)
+	return renderWorkspaceLockResults(v, results, "")
+}
+
+// workspaceUnlockAllRestore unlocks only the workspaces locked by a previous `lock all --snapshot`,
+// optionally narrowed down by the workspace selector flags
+func workspaceUnlockAllRestore(v *view.WorkspaceLockView, c *client.TfxClient, cmdConfig *flags.WorkspaceUnlockAllFlags) error {
+	v.PrintCommandHeader("Restoring workspace locks from '%s' in organization '%s'", cmdConfig.Restore, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	snapshot, err := data.ReadWorkspaceLockSnapshot(cmdConfig.Restore)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read snapshot file"))
+	}
+	if snapshot.Organization != c.OrganizationName {
+		return v.RenderError(fmt.Errorf("snapshot file was written for organization '%s'", snapshot.Organization))
+	}
+
+	var only map[string]bool
+	sel := workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags)
+	if !sel.IsEmpty() {
+		workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, sel)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+		}
+		only = make(map[string]bool, len(workspaces))
+		for _, w := range workspaces {
+			only[w.ID] = true
+		}
+	}
+
+	results := data.RestoreWorkspaceLocks(c, snapshot, only, data.DefaultParallelism)
+	return renderWorkspaceLockResults(v, results, cmdConfig.Restore)
+}
+
+// renderWorkspaceLockResults renders the result for each workspace and exits with code 1 when
+// any workspace could not be locked or unlocked
+func renderWorkspaceLockResults(v *view.WorkspaceLockView, results []view.WorkspaceLockResult, snapshotFile string) error {
+	if err := v.RenderBulk(results, snapshotFile); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
 }
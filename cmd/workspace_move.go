@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace move` command
+	workspaceMoveCmd = &cobra.Command{
+		Use:   "move",
+		Short: "Move Workspaces to a Project",
+		Long:  "Move one or more Workspaces to a Project. A rollback file is written before any workspace is moved.",
+		Example: `
+Move named workspaces to a project:
+tfx workspace move --project-name platform --name app-dev,app-prod
+
+Move every workspace with a tag from one project to another:
+tfx workspace move --project-name platform --source-project-name Default --tags team:platform
+
+Preview the moves without applying them:
+tfx workspace move --project-name platform --wildcard-name "net-*" --dry-run
+
+Undo a previous move:
+tfx workspace move --from-file tfx-move-rollback-20250101-120000.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseWorkspaceMoveFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceMove(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace move`
+	workspaceMoveCmd.Flags().String("project-name", "", "Name of the Project to move the Workspaces to.")
+	addWorkspaceSelectorFlags(workspaceMoveCmd, "source-project-name")
+	workspaceMoveCmd.Flags().String("from-file", "", "Apply the moves in a rollback file written by a previous move (optional).")
+	workspaceMoveCmd.Flags().String("rollback-file", "", "Path to write the rollback file (optional, defaults to tfx-move-rollback-<timestamp>.json).")
+	workspaceMoveCmd.Flags().Bool("dry-run", false, "Show the planned moves without applying them (optional).")
+	workspaceMoveCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to move concurrently (optional).")
+	workspaceMoveCmd.MarkFlagsOneRequired("project-name", "from-file")
+	workspaceMoveCmd.MarkFlagsMutuallyExclusive("project-name", "from-file")
+
+	workspaceCmd.AddCommand(workspaceMoveCmd)
+}
+
+func workspaceMove(cmdConfig *flags.WorkspaceMoveFlags) error {
+	v := view.NewWorkspaceMoveView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	var moves []view.WorkspaceMove
+	if cmdConfig.FromFile != "" {
+		v.PrintCommandHeader("Moving workspaces from file '%s' in organization '%s'", cmdConfig.FromFile, c.OrganizationName)
+
+		file, err := data.ReadWorkspaceMoveFile(cmdConfig.FromFile)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to read move file"))
+		}
+		if file.Organization != c.OrganizationName {
+			return v.RenderError(fmt.Errorf("move file was written for organization '%s'", file.Organization))
+		}
+
+		moves, err = data.PlanWorkspaceMovesFromFile(c, c.OrganizationName, file)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to plan workspace moves"))
+		}
+	} else {
+		v.PrintCommandHeader("Moving workspaces to project '%s' in organization '%s'", cmdConfig.ProjectName, c.OrganizationName)
+		printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+		selector := workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags)
+		if selector.IsEmpty() {
+			return v.RenderError(errors.New("at least one workspace selector is required (--name, --search, --wildcard-name, --tags, --exclude-tags or --source-project-name)"))
+		}
+
+		target, err := data.FetchProjectByName(c, c.OrganizationName, cmdConfig.ProjectName)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to read target project"))
+		}
+
+		workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+		}
+		if len(workspaces) == 0 {
+			return v.RenderError(errors.New("no workspaces matched the selector"))
+		}
+
+		moves, err = data.PlanWorkspaceMoves(c, c.OrganizationName, workspaces, target)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to plan workspace moves"))
+		}
+	}
+
+	if err := v.RenderPlan(moves); err != nil {
+		return err
+	}
+
+	denied := 0
+	for _, m := range moves {
+		if m.Denied() {
+			denied++
+		}
+	}
+	if denied > 0 {
+		return v.RenderError(fmt.Errorf("%d workspace(s) failed preflight checks, no workspaces were moved", denied))
+	}
+
+	if cmdConfig.DryRun {
+		return v.Render(moves, "", true)
+	}
+
+	movable := 0
+	for _, m := range moves {
+		if m.Movable() {
+			movable++
+		}
+	}
+	if movable == 0 {
+		return v.Render(moves, "", false)
+	}
+
+	// Write the rollback file before moving anything so an interrupted run can still be undone
+	rollbackFile := cmdConfig.RollbackFile
+	if rollbackFile == "" {
+		rollbackFile = fmt.Sprintf("tfx-move-rollback-%s.json", time.Now().Format("20060102-150405"))
+	}
+	if err := data.WriteWorkspaceMoveRollback(rollbackFile, c.OrganizationName, moves); err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to write rollback file"))
+	}
+
+	moves = data.ApplyWorkspaceMoves(c, moves, cmdConfig.Parallelism)
+	if err := v.Render(moves, rollbackFile, false); err != nil {
+		return err
+	}
+
+	// Exit non-zero on a partial failure so scripted bulk moves notice it
+	for _, m := range moves {
+		if m.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace notification` commands
+	workspaceNotificationCmd = &cobra.Command{
+		Use:   "notification",
+		Short: "Notification Commands",
+		Long:  "Commands to work with Workspace Notification Configurations.",
+	}
+
+	// `tfx workspace notification list` command
+	workspaceNotificationListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List Notifications",
+		Long:  "List Notification Configurations in a Workspace.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationList(cmdConfig)
+		},
+	}
+
+	// `tfx workspace notification create` command
+	workspaceNotificationCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create a Notification",
+		Long:  "Create a Notification Configuration in a Workspace.",
+		Example: `
+Notify a Slack channel when runs need attention or fail:
+tfx workspace notification create --name app-prod --notification-name slack-alerts --destination-type slack --url https://hooks.slack.com/services/XXX --triggers run:needs_attention,run:errored
+
+Send drift detection results to a generic webhook with an HMAC token:
+tfx workspace notification create --name app-prod --notification-name drift --destination-type generic --url https://example.com/hook --hmac-token secret --triggers assessment:drifted,assessment:failed`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationCreateFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationCreate(cmdConfig)
+		},
+	}
+
+	// `tfx workspace notification update` command
+	workspaceNotificationUpdateCmd = &cobra.Command{
+		Use:   "update",
+		Short: "Update a Notification",
+		Long:  "Update a Notification Configuration in a Workspace. Only the supplied flags are changed.",
+		Example: `
+tfx workspace notification update --name app-prod --notification-name slack-alerts --triggers run:errored
+
+Disable a notification:
+tfx workspace notification update --name app-prod --notification-name slack-alerts --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationUpdateFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationUpdate(cmdConfig)
+		},
+	}
+
+	// `tfx workspace notification delete` command
+	workspaceNotificationDeleteCmd = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a Notification",
+		Long:  "Delete a Notification Configuration from a Workspace.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationTargetFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationDelete(cmdConfig)
+		},
+	}
+
+	// `tfx workspace notification verify` command
+	workspaceNotificationVerifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Send a test Notification",
+		Long:  "Send a test notification to a Notification Configuration's destination and report the delivery response.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationTargetFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationVerify(cmdConfig)
+		},
+	}
+
+	// `tfx workspace notification apply` command
+	workspaceNotificationApplyCmd = &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a Notification template to Workspaces",
+		Long: `Create or update a Notification Configuration on every selected Workspace from a JSON template.
+A Workspace that already has a Notification Configuration with the template's name is updated.
+
+Template format:
+{
+  "name": "slack-alerts",
+  "destinationType": "slack",
+  "url": "https://hooks.slack.com/services/XXX",
+  "triggers": ["run:needs_attention", "run:errored"],
+  "enabled": true
+}`,
+		Example: `
+Preview applying a template to every production workspace:
+tfx workspace notification apply --template slack-alerts.json --tags env:prod --dry-run
+
+Apply it:
+tfx workspace notification apply --template slack-alerts.json --tags env:prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseNotificationApplyFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceNotificationApply(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	triggersUsage := fmt.Sprintf("Events that trigger the notification, one or more of: %s.", strings.Join(flags.NotificationTriggers, ", "))
+
+	// `tfx workspace notification list`
+	workspaceNotificationListCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceNotificationListCmd.MarkFlagRequired("name")
+
+	// `tfx workspace notification create`
+	workspaceNotificationCreateCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceNotificationCreateCmd.Flags().String("notification-name", "", "Name of the Notification.")
+	workspaceNotificationCreateCmd.Flags().String("destination-type", "", fmt.Sprintf("Destination type: %s.", strings.Join(flags.NotificationDestinationTypes, ", ")))
+	workspaceNotificationCreateCmd.Flags().String("url", "", "Webhook URL, required for slack, microsoft-teams and generic destinations.")
+	workspaceNotificationCreateCmd.Flags().String("hmac-token", "", "HMAC token used to sign generic webhook payloads (optional).")
+	workspaceNotificationCreateCmd.Flags().StringSlice("triggers", []string{}, triggersUsage)
+	workspaceNotificationCreateCmd.Flags().StringSlice("email-addresses", []string{}, "Email addresses for email destinations, Terraform Enterprise only (optional).")
+	workspaceNotificationCreateCmd.Flags().Bool("enabled", true, "Enable the Notification (optional, defaults to true).")
+	workspaceNotificationCreateCmd.MarkFlagRequired("name")
+	workspaceNotificationCreateCmd.MarkFlagRequired("notification-name")
+	workspaceNotificationCreateCmd.MarkFlagRequired("destination-type")
+
+	// `tfx workspace notification update`
+	addNotificationTargetFlags(workspaceNotificationUpdateCmd)
+	workspaceNotificationUpdateCmd.Flags().String("new-name", "", "New name for the Notification (optional).")
+	workspaceNotificationUpdateCmd.Flags().String("url", "", "Webhook URL (optional).")
+	workspaceNotificationUpdateCmd.Flags().String("hmac-token", "", "HMAC token used to sign generic webhook payloads (optional).")
+	workspaceNotificationUpdateCmd.Flags().StringSlice("triggers", []string{}, triggersUsage)
+	workspaceNotificationUpdateCmd.Flags().StringSlice("email-addresses", []string{}, "Email addresses for email destinations, Terraform Enterprise only (optional).")
+	workspaceNotificationUpdateCmd.Flags().Bool("enabled", true, "Enable or disable the Notification (optional).")
+
+	// `tfx workspace notification delete` and `verify`
+	addNotificationTargetFlags(workspaceNotificationDeleteCmd)
+	addNotificationTargetFlags(workspaceNotificationVerifyCmd)
+
+	// `tfx workspace notification apply`
+	addWorkspaceSelectorFlags(workspaceNotificationApplyCmd, "project-name")
+	workspaceNotificationApplyCmd.Flags().String("template", "", "Path to a JSON Notification template.")
+	workspaceNotificationApplyCmd.Flags().Bool("dry-run", false, "Show the planned changes without applying them (optional).")
+	workspaceNotificationApplyCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to change concurrently (optional).")
+	workspaceNotificationApplyCmd.MarkFlagRequired("template")
+
+	workspaceCmd.AddCommand(workspaceNotificationCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationListCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationCreateCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationUpdateCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationDeleteCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationVerifyCmd)
+	workspaceNotificationCmd.AddCommand(workspaceNotificationApplyCmd)
+}
+
+// addNotificationTargetFlags registers the flags identifying a single notification configuration
+func addNotificationTargetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	cmd.Flags().String("notification-name", "", "Name of the Notification.")
+	cmd.Flags().String("id", "", "ID of the Notification, use when names are not unique.")
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagsOneRequired("notification-name", "id")
+	cmd.MarkFlagsMutuallyExclusive("notification-name", "id")
+}
+
+// notificationLabel returns how a notification target is shown in command headers
+func notificationLabel(f flags.NotificationTargetFlags) string {
+	if f.ID != "" {
+		return f.ID
+	}
+	return f.NotificationName
+}
+
+func workspaceNotificationList(cmdConfig *flags.NotificationListFlags) error {
+	v := view.NewNotificationListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing notifications for workspace '%s' in organization '%s'", cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	configs, err := data.FetchNotificationConfigurations(c, workspaceID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list notifications"))
+	}
+
+	return v.Render(configs)
+}
+
+func workspaceNotificationCreate(cmdConfig *flags.NotificationCreateFlags) error {
+	v := view.NewNotificationView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Creating notification '%s' for workspace '%s' in organization '%s'", cmdConfig.NotificationName, cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	enabled := cmdConfig.Enabled
+	template := data.NotificationTemplate{
+		Name:            cmdConfig.NotificationName,
+		DestinationType: cmdConfig.DestinationType,
+		URL:             cmdConfig.URL,
+		Token:           cmdConfig.Token,
+		Triggers:        cmdConfig.Triggers,
+		EmailAddresses:  cmdConfig.EmailAddresses,
+		Enabled:         &enabled,
+	}
+	nc, err := data.CreateNotificationConfiguration(c, workspaceID, template.CreateOptions())
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to create notification"))
+	}
+
+	return v.Render(nc)
+}
+
+func workspaceNotificationUpdate(cmdConfig *flags.NotificationUpdateFlags) error {
+	v := view.NewNotificationView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Updating notification '%s' for workspace '%s' in organization '%s'", notificationLabel(cmdConfig.NotificationTargetFlags), cmdConfig.WorkspaceName, c.OrganizationName)
+
+	existing, err := fetchNotificationTarget(c, cmdConfig.NotificationTargetFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// The API requires a name on every update
+	opts := tfe.NotificationConfigurationUpdateOptions{
+		Name:           tfe.String(existing.Name),
+		URL:            cmdConfig.URL,
+		Token:          cmdConfig.Token,
+		Enabled:        cmdConfig.Enabled,
+		EmailAddresses: cmdConfig.EmailAddresses,
+	}
+	if cmdConfig.NewName != nil {
+		opts.Name = cmdConfig.NewName
+	}
+	if cmdConfig.Triggers != nil {
+		for _, t := range cmdConfig.Triggers {
+			opts.Triggers = append(opts.Triggers, tfe.NotificationTriggerType(t))
+		}
+	}
+
+	nc, err := data.UpdateNotificationConfiguration(c, existing.ID, opts)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to update notification"))
+	}
+
+	return v.Render(nc)
+}
+
+func workspaceNotificationDelete(cmdConfig *flags.NotificationTargetFlags) error {
+	v := view.NewNotificationDeleteView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Deleting notification '%s' for workspace '%s' in organization '%s'", notificationLabel(*cmdConfig), cmdConfig.WorkspaceName, c.OrganizationName)
+
+	nc, err := fetchNotificationTarget(c, *cmdConfig)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	if err := data.DeleteNotificationConfiguration(c, nc.ID); err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to delete notification"))
+	}
+
+	return v.Render(nc)
+}
+
+func workspaceNotificationVerify(cmdConfig *flags.NotificationTargetFlags) error {
+	v := view.NewNotificationVerifyView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Sending test notification '%s' for workspace '%s' in organization '%s'", notificationLabel(*cmdConfig), cmdConfig.WorkspaceName, c.OrganizationName)
+
+	nc, err := fetchNotificationTarget(c, *cmdConfig)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	verified, err := data.VerifyNotificationConfiguration(c, nc.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to send test notification"))
+	}
+
+	if err := v.Render(verified); err != nil {
+		return err
+	}
+	if !view.NotificationDelivered(verified) {
+		return v.RenderError(errors.New("test notification was not delivered successfully"))
+	}
+	return nil
+}
+
+func workspaceNotificationApply(cmdConfig *flags.NotificationApplyFlags) error {
+	v := view.NewNotificationApplyView()
+
+	template, err := data.ReadNotificationTemplate(cmdConfig.Template)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read notification template"))
+	}
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Applying notification '%s' in organization '%s'", template.Name, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	selector := workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags)
+	if selector.IsEmpty() {
+		return v.RenderError(errors.New("at least one workspace selector is required (--name, --search, --wildcard-name, --tags, --exclude-tags or --project-name)"))
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+	if len(workspaces) == 0 {
+		return v.RenderError(errors.New("no workspaces matched the selector"))
+	}
+
+	results := data.ApplyNotificationTemplate(c, workspaces, template, cmdConfig.DryRun, cmdConfig.Parallelism)
+	if err := v.Render(template.Name, results, cmdConfig.DryRun); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
+
+// fetchNotificationTarget reads the notification configuration identified by the target flags
+func fetchNotificationTarget(c *client.TfxClient, f flags.NotificationTargetFlags) (*tfe.NotificationConfiguration, error) {
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, f.WorkspaceName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read workspace")
+	}
+
+	nc, err := data.FetchNotificationConfiguration(c, workspaceID, f.NotificationName, f.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read notification")
+	}
+	return nc, nil
+}
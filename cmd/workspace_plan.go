@@ -4,6 +4,10 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -11,6 +15,7 @@ import (
 	"github.com/straubt1/tfx/cmd/flags"
 	view "github.com/straubt1/tfx/cmd/views"
 	"github.com/straubt1/tfx/data"
+	"github.com/straubt1/tfx/pkg/runlog"
 )
 
 var (
@@ -39,7 +44,14 @@ var (
 	planLogsCmd = &cobra.Command{
 		Use:   "logs",
 		Short: "Show Plan Logs",
-		Long:  "Show Plan logs for a TFx Workspace.",
+		Long: `Show Plan logs for a TFx Workspace.
+Use --diagnostics to only show errors and warnings, read from structured run output or from
+Terraform's error and warning blocks, and --format github to write them as GitHub Actions annotations.`,
+		Example: `
+tfx workspace plan logs --id plan-CKNawhfgSGdJoGPx --diagnostics
+
+Annotate a pull request from a GitHub Actions job:
+tfx workspace plan logs --id plan-CKNawhfgSGdJoGPx --format github`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParsePlanLogsFlags(cmd)
 			if err != nil {
@@ -63,11 +75,65 @@ var (
 		},
 	}
 
+	// `tfx workspace plan summary` command
+	planSummaryCmd = &cobra.Command{
+		Use:   "summary",
+		Short: "Summarize Plan Changes",
+		Long: `Summarize the resource changes of a Plan from its JSON output, grouped by action, module and
+provider. Updates and replaces list the attributes that change, sensitive values are never shown.
+Plans that delete or replace resources are flagged. Use --format markdown to post the summary as
+a pull request comment.`,
+		Example: `
+tfx workspace plan summary --id plan-CKNawhfgSGdJoGPx
+
+tfx workspace plan summary --id plan-CKNawhfgSGdJoGPx --format markdown > plan-summary.md`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParsePlanSummaryFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return planSummary(cmdConfig)
+		},
+	}
+
+	// `tfx workspace plan compare` command
+	planCompareCmd = &cobra.Command{
+		Use:   "compare",
+		Short: "Compare Plans",
+		Long: `Compare the resource changes of two Plans from their JSON output. Reports the resources that
+appear or disappear in --other, those the Plans take a different action on and those whose attribute
+diffs differ. Attribute values are never shown. Useful to verify a refactor plans the same changes.`,
+		Example: `
+tfx workspace plan compare --id plan-CKNawhfgSGdJoGPx --other plan-Vz4nq1wPnkb7Kf3R
+
+Fail a CI job when a refactor changes the plan:
+tfx workspace plan compare --id plan-CKNawhfgSGdJoGPx --other plan-Vz4nq1wPnkb7Kf3R --fail-on-diff`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParsePlanCompareFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return planCompare(cmdConfig)
+		},
+	}
+
 	// `tfx workspace plan create` command
 	planCreateCmd = &cobra.Command{
 		Use:   "create",
 		Short: "Create Plan",
-		Long:  "Create a new Plan for a TFx Workspace.",
+		Long: `Create a new Plan for a TFx Workspace.
+The Terraform code in --directory is uploaded to a new Configuration Version, or an existing one is
+reused with --configuration-id. Use --speculative or --plan-only for a Run that can not be applied.
+Values from --var and TF_VAR_ values from --env are set as strings for this Run only, the Workspace
+variables are not changed. Use --var-hcl for a value that is an HCL expression, such as a list.`,
+		Example: `
+tfx workspace plan create --name tfx-test --directory ./terraform
+
+Speculative plan with a variable override:
+tfx workspace plan create --name tfx-test --speculative --var region=us-east-1 --var-hcl 'azs=["us-east-1a", "us-east-1b"]'
+
+Plan a replacement of a single resource from an existing configuration version:
+tfx workspace plan create --name tfx-test --configuration-id cv-VYikVwjgfHNnUYfr --replace aws_instance.web`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParsePlanCreateFlags(cmd)
 			if err != nil {
@@ -76,7 +142,6 @@ var (
 			return planCreate(cmdConfig)
 		},
 	}
-
 )
 
 func init() {
@@ -86,26 +151,52 @@ func init() {
 
 	// `tfx workspace plan logs` command
 	planLogsCmd.Flags().StringP("id", "i", "", "Plan Id (i.e. plan-*)")
+	planLogsCmd.Flags().Bool("diagnostics", false, "Only show errors and warnings, with the file and line they refer to (optional)")
+	planLogsCmd.Flags().String("format", "text", fmt.Sprintf("Output format (optional, one of: %s). github writes diagnostics as GitHub Actions annotations.", strings.Join(flags.LogFormats, ", ")))
 	planLogsCmd.MarkFlagRequired("id")
 
 	// `tfx workspace plan jsonoutput` command
 	planJSONOutputCmd.Flags().StringP("id", "i", "", "Plan Id (i.e. plan-*)")
 	planJSONOutputCmd.MarkFlagRequired("id")
 
+	// `tfx workspace plan summary` command
+	planSummaryCmd.Flags().StringP("id", "i", "", "Plan Id (i.e. plan-*)")
+	planSummaryCmd.Flags().String("format", "table", fmt.Sprintf("Output format (optional, one of: %s).", strings.Join(flags.PlanSummaryFormats, ", ")))
+	planSummaryCmd.MarkFlagRequired("id")
+
+	// `tfx workspace plan compare` command
+	planCompareCmd.Flags().StringP("id", "i", "", "Plan Id to compare against (i.e. plan-*)")
+	planCompareCmd.Flags().String("other", "", "Plan Id to compare (i.e. plan-*)")
+	planCompareCmd.Flags().Bool("fail-on-diff", false, "Exit with an error when the Plans differ (optional)")
+	planCompareCmd.MarkFlagRequired("id")
+	planCompareCmd.MarkFlagRequired("other")
+
 	// `tfx workspace plan create` command
 	planCreateCmd.Flags().StringP("name", "n", "", "Workspace name")
-	planCreateCmd.Flags().StringP("directory", "d", "./", "Directory of Terraform (optional, defaults to current directory)")
-	planCreateCmd.Flags().StringP("configuration-id", "i", "", "Configuration Version Id (optional, i.e. cv-*)")
+	planCreateCmd.Flags().StringP("directory", "d", "./", "Directory of Terraform to upload (optional, defaults to current directory)")
+	planCreateCmd.Flags().StringP("configuration-id", "i", "", "Existing Configuration Version Id to plan instead of uploading (optional, i.e. cv-*)")
 	planCreateCmd.Flags().StringP("message", "m", "", "Run Message (optional)")
-	planCreateCmd.Flags().Bool("speculative", false, "Perform a Speculative Plan (optional)")
+	planCreateCmd.Flags().Bool("speculative", false, "Upload a Speculative Configuration Version and create a plan only Run (optional)")
+	planCreateCmd.Flags().Bool("plan-only", false, "Create a plan only Run that can not be applied (optional)")
 	planCreateCmd.Flags().Bool("destroy", false, "Perform a Destroy Plan (optional)")
-	planCreateCmd.Flags().StringSlice("env", []string{}, "Environment variables to write to the Workspace. Can be supplied multiple times. (optional, i.e. '--env='AWS_REGION=us-east1')")
+	planCreateCmd.Flags().Bool("refresh-only", false, "Perform a Refresh Only Plan (optional)")
+	planCreateCmd.Flags().StringArray("target", []string{}, "Resource address to target, can be supplied multiple times (optional)")
+	planCreateCmd.Flags().StringArray("replace", []string{}, "Resource address to replace, can be supplied multiple times (optional)")
+	planCreateCmd.Flags().StringArray("var", []string{}, "Terraform variable for this Run only, the value is passed as a string, can be supplied multiple times (optional, i.e. '--var=region=us-east-1')")
+	planCreateCmd.Flags().StringArray("var-hcl", []string{}, "Terraform variable for this Run only, the value is passed as an HCL expression, can be supplied multiple times (optional, i.e. '--var-hcl=azs=[\"us-east-1a\"]')")
+	planCreateCmd.Flags().StringArray("env", []string{}, "TF_VAR_ environment variable for this Run only, can be supplied multiple times (optional, i.e. '--env=TF_VAR_region=us-east-1')")
+	planCreateCmd.Flags().Bool("wait", false, "Wait for the Run to finish, streaming logs and exiting with a code for the outcome (optional)")
+	planCreateCmd.Flags().Duration("timeout", 0, "Maximum time to wait with --wait, i.e. 30m (optional)")
 	planCreateCmd.MarkFlagRequired("name")
+	planCreateCmd.MarkFlagsMutuallyExclusive("directory", "configuration-id")
+	planCreateCmd.MarkFlagsMutuallyExclusive("destroy", "refresh-only")
 
 	workspaceCmd.AddCommand(planCmd)
 	planCmd.AddCommand(planShowCmd)
 	planCmd.AddCommand(planLogsCmd)
 	planCmd.AddCommand(planJSONOutputCmd)
+	planCmd.AddCommand(planSummaryCmd)
+	planCmd.AddCommand(planCompareCmd)
 	planCmd.AddCommand(planCreateCmd)
 }
 
@@ -135,14 +226,24 @@ func planLogs(cmdConfig *flags.PlanLogsFlags) error {
 		return v.RenderError(err)
 	}
 
-	v.PrintCommandHeader("Showing logs for plan '%s'", cmdConfig.ID)
+	// Annotations are read by GitHub Actions from stdout, so only print a header for text
+	if cmdConfig.Format == "text" {
+		v.PrintCommandHeader("Showing logs for plan '%s'", cmdConfig.ID)
+	}
 
 	logs, err := data.FetchPlanLogs(c, cmdConfig.ID)
 	if err != nil {
 		return v.RenderError(errors.Wrap(err, "failed to read plan logs"))
 	}
+	if !cmdConfig.Diagnostics {
+		return v.Render(logs)
+	}
 
-	return v.Render(logs)
+	diagnostics := runlog.Diagnostics(runlog.Parse(logs))
+	if cmdConfig.Format == "github" {
+		return v.RenderGitHubAnnotations(diagnostics)
+	}
+	return v.RenderDiagnostics(diagnostics)
 }
 
 func planJSONOutput(cmdConfig *flags.PlanJSONOutputFlags) error {
@@ -163,6 +264,58 @@ func planJSONOutput(cmdConfig *flags.PlanJSONOutputFlags) error {
 	return v.Render(jsonOutput)
 }
 
+func planSummary(cmdConfig *flags.PlanSummaryFlags) error {
+	v := view.NewPlanSummaryView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	// Markdown goes to stdout to be redirected, so only print a header for the table
+	if cmdConfig.Format == "table" {
+		v.PrintCommandHeader("Summarizing changes for plan '%s'", cmdConfig.ID)
+	}
+
+	summary, err := data.FetchPlanChangeSummary(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read plan JSON output"))
+	}
+
+	switch {
+	case cmdConfig.Format == "markdown" && !v.IsJSON():
+		return v.RenderMarkdown(cmdConfig.ID, summary)
+	case cmdConfig.Format == "json":
+		return v.RenderJSON(cmdConfig.ID, summary)
+	default:
+		return v.Render(cmdConfig.ID, summary)
+	}
+}
+
+func planCompare(cmdConfig *flags.PlanCompareFlags) error {
+	v := view.NewPlanCompareView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Comparing plan '%s' to plan '%s'", cmdConfig.OtherID, cmdConfig.ID)
+
+	comparison, err := data.FetchPlanComparison(c, cmdConfig.ID, cmdConfig.OtherID)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	if err := v.Render(cmdConfig.ID, cmdConfig.OtherID, comparison); err != nil {
+		return err
+	}
+	if cmdConfig.FailOnDiff && comparison.Differences() > 0 {
+		return fmt.Errorf("%d resource(s) differ between the plans", comparison.Differences())
+	}
+	return nil
+}
+
 func planCreate(cmdConfig *flags.PlanCreateFlags) error {
 	// Create a view for output
 	v := view.NewPlanCreateView()
@@ -171,44 +324,83 @@ func planCreate(cmdConfig *flags.PlanCreateFlags) error {
 	if err != nil {
 		return v.RenderError(err)
 	}
+	// renderErr renders an error, exiting with the errored outcome code when waiting
+	renderErr := func(err error) error {
+		if cmdConfig.Wait {
+			return renderWatchError(v.BaseView, err)
+		}
+		return v.RenderError(err)
+	}
 
 	// Read workspace by name
 	workspace, err := c.Client.Workspaces.Read(c.Context, c.OrganizationName, cmdConfig.WorkspaceName)
 	if err != nil {
-		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+		return renderErr(errors.Wrap(err, "failed to read workspace"))
 	}
 
 	v.PrintCommandHeader("Creating plan for workspace '%s' (%s)", cmdConfig.WorkspaceName, workspace.ID)
 
-	// Create a new run with plan
-	runOptions := tfe.RunCreateOptions{
-		IsDestroy: tfe.Bool(cmdConfig.Destroy),
-		Workspace: workspace,
-	}
-
-	// Add optional message if provided
-	if cmdConfig.Message != "" {
-		runOptions.Message = tfe.String(cmdConfig.Message)
+	// Reuse the given configuration version, or upload the directory to a new one
+	var cv *tfe.ConfigurationVersion
+	if cmdConfig.ConfigurationID != "" {
+		cv, err = data.FetchConfigurationVersion(c, cmdConfig.ConfigurationID)
+		if err != nil {
+			return renderErr(errors.Wrap(err, "failed to read configuration version"))
+		}
+	} else {
+		if info, err := os.Stat(cmdConfig.Directory); err != nil || !info.IsDir() {
+			return renderErr(errors.Errorf("directory '%s' does not exist", cmdConfig.Directory))
+		}
+		v.Output().Message("Uploading '%s' to a new Configuration Version...", cmdConfig.Directory)
+		cv, err = data.CreateConfigurationVersion(c, c.OrganizationName, cmdConfig.WorkspaceName, cmdConfig.Directory, cmdConfig.Speculative)
+		if err != nil {
+			return renderErr(err)
+		}
+		cv, err = data.WaitForConfigurationVersionUpload(c, cv.ID)
+		if err != nil {
+			return renderErr(errors.Wrap(err, "failed to process uploaded code"))
+		}
 	}
 
 	// Create the run
 	v.Output().Message("Creating new Plan...")
-	run, err := c.Client.Runs.Create(c.Context, runOptions)
+	run, err := data.CreatePlanRun(c, workspace, cv, data.PlanRunOptions{
+		Message:      cmdConfig.Message,
+		PlanOnly:     cmdConfig.PlanOnly || cmdConfig.Speculative,
+		Destroy:      cmdConfig.Destroy,
+		RefreshOnly:  cmdConfig.RefreshOnly,
+		Targets:      cmdConfig.Targets,
+		Replaces:     cmdConfig.Replaces,
+		Variables:    cmdConfig.Variables,
+		HCLVariables: cmdConfig.HCLVariables,
+	})
 	if err != nil {
-		return v.RenderError(errors.Wrap(err, "failed to create run"))
+		return renderErr(errors.Wrap(err, "failed to create run"))
 	}
 
 	// Fetch and display the plan details
 	plan, err := data.FetchPlan(c, run.Plan.ID)
 	if err != nil {
-		return v.RenderError(errors.Wrap(err, "failed to fetch plan details"))
+		return renderErr(errors.Wrap(err, "failed to fetch plan details"))
 	}
 
-	return v.Render(plan, &view.PlanCreateRenderOptions{
-		RunID:        run.ID,
-		PlanID:       run.Plan.ID,
-		Hostname:     c.Hostname,
-		Organization: c.OrganizationName,
-		Workspace:    cmdConfig.WorkspaceName,
-	})
+	renderOptions := &view.PlanCreateRenderOptions{
+		RunID:                  run.ID,
+		PlanID:                 run.Plan.ID,
+		ConfigurationVersionID: cv.ID,
+		Hostname:               c.Hostname,
+		Organization:           c.OrganizationName,
+		Workspace:              cmdConfig.WorkspaceName,
+	}
+	if !cmdConfig.Wait {
+		return v.Render(plan, renderOptions)
+	}
+
+	// In JSON mode only the final summary is rendered so the output stays a single document
+	if !v.IsJSON() {
+		if err := v.Render(plan, renderOptions); err != nil {
+			return err
+		}
+	}
+	return watchRun(c, run.ID, cmdConfig.WorkspaceName, cmdConfig.Timeout)
 }
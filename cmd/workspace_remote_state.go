@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace remote-state` commands
+	workspaceRemoteStateCmd = &cobra.Command{
+		Use:   "remote-state",
+		Short: "Remote State Sharing Commands",
+		Long:  "Manage which Workspaces can read the state of a Workspace.",
+	}
+
+	// `tfx workspace remote-state show` command
+	workspaceRemoteStateShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show remote state sharing",
+		Long:  "Show whether a Workspace shares its state globally and which Workspaces can read it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateShowFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateShow(cmdConfig)
+		},
+	}
+
+	// `tfx workspace remote-state set-global` command
+	workspaceRemoteStateSetGlobalCmd = &cobra.Command{
+		Use:   "set-global",
+		Short: "Enable or disable global remote state sharing",
+		Long:  "Enable or disable sharing a Workspace's state with every Workspace in the Organization.",
+		Example: `
+Share state with every workspace:
+tfx workspace remote-state set-global --name network-prod
+
+Stop sharing state globally, the consumer list applies again:
+tfx workspace remote-state set-global --name network-prod --enabled=false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateSetGlobalFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateSetGlobal(cmdConfig)
+		},
+	}
+
+	// `tfx workspace remote-state add-consumer` command
+	workspaceRemoteStateAddConsumerCmd = &cobra.Command{
+		Use:   "add-consumer",
+		Short: "Add remote state consumers",
+		Long:  "Allow one or more Workspaces to read the state of a Workspace.",
+		Example: `
+tfx workspace remote-state add-consumer --name network-prod --consumer-name app-prod,db-prod
+
+Add every workspace in a project:
+tfx workspace remote-state add-consumer --name network-prod --consumer-project-name platform`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateConsumerFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateChange(cmdConfig, "Adding", data.PlanRemoteStateConsumerAdd)
+		},
+	}
+
+	// `tfx workspace remote-state remove-consumer` command
+	workspaceRemoteStateRemoveConsumerCmd = &cobra.Command{
+		Use:   "remove-consumer",
+		Short: "Remove remote state consumers",
+		Long:  "Stop one or more Workspaces from reading the state of a Workspace.",
+		Example: `
+tfx workspace remote-state remove-consumer --name network-prod --consumer-wildcard-name "legacy-*"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateConsumerFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateChange(cmdConfig, "Removing", data.PlanRemoteStateConsumerRemove)
+		},
+	}
+
+	// `tfx workspace remote-state sync` command
+	workspaceRemoteStateSyncCmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Replace remote state consumers",
+		Long:  "Make the selected Workspaces the only consumers of a Workspace's state. Consumers that are not selected are removed.",
+		Example: `
+Preview the changes:
+tfx workspace remote-state sync --name network-prod --consumer-tags env:prod --dry-run
+
+Apply them:
+tfx workspace remote-state sync --name network-prod --consumer-tags env:prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateConsumerFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateChange(cmdConfig, "Syncing", data.PlanRemoteStateConsumerSync)
+		},
+	}
+
+	// `tfx workspace remote-state report` command
+	workspaceRemoteStateReportCmd = &cobra.Command{
+		Use:   "report",
+		Short: "Report remote state sharing",
+		Long:  "Report the state sharing configuration of Workspaces, flagging Workspaces that share state with the whole Organization.",
+		Example: `
+tfx workspace remote-state report
+
+Only list workspaces sharing state globally in a project:
+tfx workspace remote-state report --project-name platform --global-only`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRemoteStateReportFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRemoteStateReport(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace remote-state show`
+	workspaceRemoteStateShowCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceRemoteStateShowCmd.MarkFlagRequired("name")
+
+	// `tfx workspace remote-state set-global`
+	workspaceRemoteStateSetGlobalCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceRemoteStateSetGlobalCmd.Flags().Bool("enabled", true, "Share state with every Workspace in the Organization (optional, defaults to true).")
+	workspaceRemoteStateSetGlobalCmd.MarkFlagRequired("name")
+
+	// `tfx workspace remote-state add-consumer`, `remove-consumer` and `sync`
+	for _, cmd := range []*cobra.Command{workspaceRemoteStateAddConsumerCmd, workspaceRemoteStateRemoveConsumerCmd, workspaceRemoteStateSyncCmd} {
+		cmd.Flags().StringP("name", "n", "", "Name of the Workspace whose state is shared.")
+		addConsumerSelectorFlags(cmd)
+		cmd.Flags().Bool("dry-run", false, "Show the planned changes without applying them (optional).")
+		cmd.MarkFlagRequired("name")
+	}
+
+	// `tfx workspace remote-state report`
+	addWorkspaceSelectorFlags(workspaceRemoteStateReportCmd, "project-name")
+	workspaceRemoteStateReportCmd.Flags().Bool("global-only", false, "Only report Workspaces sharing state globally (optional).")
+	workspaceRemoteStateReportCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently (optional).")
+
+	workspaceCmd.AddCommand(workspaceRemoteStateCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateShowCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateSetGlobalCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateAddConsumerCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateRemoveConsumerCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateSyncCmd)
+	workspaceRemoteStateCmd.AddCommand(workspaceRemoteStateReportCmd)
+}
+
+// addConsumerSelectorFlags registers the workspace selector flags with a consumer- prefix,
+// for commands where --name already identifies the workspace being changed
+func addConsumerSelectorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("consumer-name", []string{}, "Consumer Workspace name, can be supplied multiple times or comma separated (optional).")
+	cmd.Flags().String("consumer-search", "", "Search string anywhere in the consumer Workspace Name (optional).")
+	cmd.Flags().String("consumer-wildcard-name", "", "Wildcard search string for consumer Workspace Name, Examples: *-prod or prod-* (optional).")
+	cmd.Flags().String("consumer-tags", "", "Select consumer Workspaces with this tag (optional).")
+	cmd.Flags().String("consumer-exclude-tags", "", "Exclude consumer Workspaces with this tag (optional).")
+	cmd.Flags().String("consumer-project-name", "", "Select consumer Workspaces in this Project (optional).")
+}
+
+func workspaceRemoteStateShow(cmdConfig *flags.RemoteStateShowFlags) error {
+	v := view.NewRemoteStateShowView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing remote state sharing for workspace '%s' in organization '%s'", cmdConfig.Name, c.OrganizationName)
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.Name)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	consumers, err := data.FetchWorkspaceRemoteStateConsumers(c, workspace.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list remote state consumers"))
+	}
+
+	return v.Render(workspace, consumers)
+}
+
+func workspaceRemoteStateSetGlobal(cmdConfig *flags.RemoteStateSetGlobalFlags) error {
+	v := view.NewRemoteStateSetGlobalView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Setting global remote state sharing to '%t' for workspace '%s' in organization '%s'", cmdConfig.Enabled, cmdConfig.Name, c.OrganizationName)
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.Name)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	previous := workspace.GlobalRemoteState
+	if previous != cmdConfig.Enabled {
+		workspace, err = data.SetGlobalRemoteState(c, workspace.ID, cmdConfig.Enabled)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to update workspace"))
+		}
+	}
+
+	return v.Render(workspace, previous)
+}
+
+// workspaceRemoteStateChange plans and applies a change to the consumers of a workspace
+func workspaceRemoteStateChange(cmdConfig *flags.RemoteStateConsumerFlags, action string, plan func(*tfe.Workspace, []*tfe.Workspace, []*tfe.Workspace) []view.RemoteStateConsumerChange) error {
+	v := view.NewRemoteStateChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("%s remote state consumers for workspace '%s' in organization '%s'", action, cmdConfig.Name, c.OrganizationName)
+	printSelectorFilters(v.BaseView, cmdConfig.Consumers, "consumer-")
+
+	selector := workspaceSelectorFromFlags(cmdConfig.Consumers)
+	if selector.IsEmpty() {
+		return v.RenderError(errors.New("at least one consumer selector is required (--consumer-name, --consumer-search, --consumer-wildcard-name, --consumer-tags, --consumer-exclude-tags or --consumer-project-name)"))
+	}
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.Name)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	current, err := data.FetchWorkspaceRemoteStateConsumers(c, workspace.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list remote state consumers"))
+	}
+
+	selected, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list consumer workspaces"))
+	}
+	if len(selected) == 0 {
+		return v.RenderError(errors.New("no workspaces matched the consumer selector"))
+	}
+
+	changes := plan(workspace, current, selected)
+	if cmdConfig.DryRun {
+		return v.Render(workspace, changes, true)
+	}
+
+	changes = data.ApplyRemoteStateConsumerChanges(c, workspace.ID, changes)
+	if err := v.Render(workspace, changes, false); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if change.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
+
+func workspaceRemoteStateReport(cmdConfig *flags.RemoteStateReportFlags) error {
+	v := view.NewRemoteStateReportView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Reporting remote state sharing in organization '%s'", c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	entries := data.FetchRemoteStateReport(c, workspaces, cmdConfig.GlobalOnly, cmdConfig.Parallelism)
+	return v.Render(entries)
+}
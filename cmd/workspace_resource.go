@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace resource` commands
+	workspaceResourceCmd = &cobra.Command{
+		Use:   "resource",
+		Short: "Resource Commands",
+		Long:  "Work with the resources managed by Workspaces.",
+	}
+
+	// `tfx workspace resource list` command
+	workspaceResourceListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List resources",
+		Long:  "List the resources managed by a Workspace and the run that last modified each one.",
+		Example: `
+tfx workspace resource list --name app-prod
+
+Only list instances and buckets in the network module:
+tfx workspace resource list --name app-prod --type aws_instance,aws_s3_bucket --module module.network`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseResourceListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceResourceList(cmdConfig)
+		},
+	}
+
+	// `tfx workspace resource count` command
+	workspaceResourceCountCmd = &cobra.Command{
+		Use:   "count",
+		Short: "Count resources per Workspace",
+		Long: `Count the resources managed by every selected Workspace, for resources under management (RUM)
+and licensing estimates. Use --billable to list the resources of each Workspace and leave out
+resource types that do not count towards RUM.`,
+		Example: `
+tfx workspace resource count
+
+Estimate RUM for a project:
+tfx workspace resource count --project-name platform --billable`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseResourceCountFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceResourceCount(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace resource list`
+	workspaceResourceListCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceResourceListCmd.Flags().StringSlice("type", []string{}, "Resource type, can be supplied multiple times or comma separated (optional).")
+	workspaceResourceListCmd.Flags().String("module", "", "Only list resources in this module or its child modules (optional).")
+	workspaceResourceListCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of state versions to read concurrently when finding runs (optional).")
+	workspaceResourceListCmd.MarkFlagRequired("name")
+
+	// `tfx workspace resource count`
+	addWorkspaceSelectorFlags(workspaceResourceCountCmd, "project-name")
+	workspaceResourceCountCmd.Flags().Bool("billable", false, "List each Workspace's resources to count only those under management (optional).")
+	workspaceResourceCountCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to read concurrently with --billable (optional).")
+
+	workspaceCmd.AddCommand(workspaceResourceCmd)
+	workspaceResourceCmd.AddCommand(workspaceResourceListCmd)
+	workspaceResourceCmd.AddCommand(workspaceResourceCountCmd)
+}
+
+func workspaceResourceList(cmdConfig *flags.ResourceListFlags) error {
+	v := view.NewWorkspaceResourceListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing resources for workspace '%s' in organization '%s'", cmdConfig.WorkspaceName, c.OrganizationName)
+	if len(cmdConfig.Types) > 0 {
+		v.PrintCommandFilter("Filtering on type: %s", strings.Join(cmdConfig.Types, ", "))
+	}
+	if cmdConfig.Module != "" {
+		v.PrintCommandFilter("Filtering on module: %s", cmdConfig.Module)
+	}
+
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	filter := data.ResourceFilter{Types: cmdConfig.Types, Module: cmdConfig.Module}
+	resources, err := data.FetchWorkspaceResourceList(c, workspaceID, filter, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list resources"))
+	}
+
+	return v.Render(resources)
+}
+
+func workspaceResourceCount(cmdConfig *flags.ResourceCountFlags) error {
+	v := view.NewWorkspaceResourceCountView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Counting resources for workspaces in organization '%s'", c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, workspaceSelectorFromFlags(cmdConfig.WorkspaceSelectorFlags))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list workspaces"))
+	}
+
+	counts, err := data.FetchResourceCounts(c, c.OrganizationName, workspaces, cmdConfig.Billable, cmdConfig.Parallelism)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to count resources"))
+	}
+
+	return v.Render(counts, cmdConfig.Billable)
+}
@@ -4,14 +4,20 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/straubt1/tfx/client"
 	"github.com/straubt1/tfx/cmd/flags"
 	view "github.com/straubt1/tfx/cmd/views"
 	"github.com/straubt1/tfx/data"
+	pkgfile "github.com/straubt1/tfx/pkg/file"
+	"github.com/straubt1/tfx/pkg/runtimeline"
 )
 
 var (
@@ -26,7 +32,17 @@ var (
 	runListCmd = &cobra.Command{
 		Use:   "list",
 		Short: "List Runs",
-		Long:  "List Runs of a TFx Workspace.",
+		Long: `List Runs of a TFx Workspace.
+With --needs-confirmation, list the Runs waiting for confirmation across the Organization, or in
+the Workspace when --name is set. Add --interactive to review the plan of each Run and apply it.`,
+		Example: `
+tfx workspace run list --name tfx-test
+
+List runs waiting for confirmation across the organization:
+tfx workspace run list --needs-confirmation
+
+Review and approve pending applies one by one:
+tfx workspace run list --needs-confirmation --interactive`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParseRunListFlags(cmd)
 			if err != nil {
@@ -64,6 +80,123 @@ var (
 		},
 	}
 
+	// `tfx workspace run watch` command
+	runWatchCmd = &cobra.Command{
+		Use:   "watch",
+		Short: "Watch Run",
+		Long: `Wait for a Run to finish, streaming the plan and then the apply logs as they are written.
+The exit code maps to the outcome of the Run so CI can branch on it:
+  0 applied
+  1 errored
+  2 planned with changes (including Runs waiting for confirmation)
+  3 planned with no changes
+  4 canceled or discarded`,
+		Example: `
+tfx workspace run watch --id run-CZcmD7eagjhyX0vN
+
+Give up after 30 minutes:
+tfx workspace run watch --id run-CZcmD7eagjhyX0vN --timeout 30m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunWatchFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runWatch(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run timeline` command
+	runTimelineCmd = &cobra.Command{
+		Use:   "timeline",
+		Short: "Run Timeline",
+		Long: `Show how long a Run spent in each phase, from waiting in the queue through planning, policy
+checks and run tasks, waiting for confirmation and applying. Phases that are still running are
+measured until now.`,
+		Example: `
+tfx workspace run timeline --id run-CZcmD7eagjhyX0vN`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTimelineFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runTimeline(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run cost` command
+	runCostCmd = &cobra.Command{
+		Use:   "cost",
+		Short: "Run Cost Estimate",
+		Long: `Show the cost estimate of a Run, with the prior and proposed monthly cost, the change in cost
+and the estimated monthly cost of each resource. Requires cost estimation to be enabled for the
+Organization.`,
+		Example: `
+tfx workspace run cost --id run-CZcmD7eagjhyX0vN`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunCostFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runCost(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run logs` command
+	runLogsCmd = &cobra.Command{
+		Use:   "logs",
+		Short: "Download Run Logs",
+		Long: `Download everything a Run produced into one directory, or a tarball when --output ends in
+.tar.gz or .tgz: the Run details, plan and apply logs, policy check logs, task stage and policy
+evaluation results and the cost estimate. A manifest.json lists the files and any outputs the Run
+did not produce. Useful for attaching to an incident ticket.`,
+		Example: `
+tfx workspace run logs --id run-CZcmD7eagjhyX0vN
+
+tfx workspace run logs --id run-CZcmD7eagjhyX0vN --output ./incident-1234.tar.gz`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunLogsFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runLogs(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run apply` command
+	runApplyCmd = &cobra.Command{
+		Use:   "apply",
+		Short: "Apply Run",
+		Long:  "Confirm a Run that is waiting for confirmation so it applies. Use --interactive to review the plan first.",
+		Example: `
+tfx workspace run apply --id run-CZcmD7eagjhyX0vN --comment "Reviewed in CAB-1234"
+
+Review the plan before confirming:
+tfx workspace run apply --id run-CZcmD7eagjhyX0vN --interactive`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunApplyFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runApply(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run comment` command
+	runCommentCmd = &cobra.Command{
+		Use:   "comment",
+		Short: "Comment on Run",
+		Long:  "Add a comment to a Run.",
+		Example: `
+tfx workspace run comment --id run-CZcmD7eagjhyX0vN --body "Waiting on the network change window"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunCommentFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runComment(cmdConfig)
+		},
+	}
+
 	// `tfx workspace run discard` command
 	runDiscardCmd = &cobra.Command{
 		Use:   "discard",
@@ -96,7 +229,13 @@ var (
 	runPolicyCmd = &cobra.Command{
 		Use:   "policy",
 		Short: "Show Run Policy Details",
-		Long:  "Show Policy Check and Evaluation details for a Workspace Run.",
+		Long: `Show Policy Check and Evaluation details for a Workspace Run. Use --format junit or sarif to
+report each policy as a test case to CI, with its enforcement level, status and output. With
+these formats the command exits with 2 when a policy that can not be overridden failed.`,
+		Example: `
+tfx workspace run policy --name tfx-test
+
+tfx workspace run policy --id run-SFSeL9fg6kibje8L --format junit > policy-results.xml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdConfig, err := flags.ParseRunPolicyFlags(cmd)
 			if err != nil {
@@ -105,27 +244,78 @@ var (
 			return runPolicy(cmdConfig)
 		},
 	}
+
+	// `tfx workspace run policy override` command
+	runPolicyOverrideCmd = &cobra.Command{
+		Use:   "override",
+		Short: "Override Run Policy Failures",
+		Long: `Override the soft-mandatory policy failures that stopped a Run, with a comment on the Run
+explaining why. Covers both legacy Sentinel Policy Checks and Policy Evaluations in task stages.
+Nothing is overridden unless you have permission to override all of them.`,
+		Example: `
+tfx workspace run policy override --id run-CZcmD7eagjhyX0vN --comment "Approved by security, see CHG-1234"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunPolicyOverrideFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return runPolicyOverride(cmdConfig)
+		},
+	}
 )
 
 func init() {
 	// `tfx workspace run` commands
 
 	// `tfx workspace run list` command
-	runListCmd.Flags().StringP("name", "n", "", "Workspace name")
+	runListCmd.Flags().StringP("name", "n", "", "Workspace name (optional with --needs-confirmation)")
 	runListCmd.Flags().IntP("max-items", "m", 10, "Max number of results (optional)")
-	runListCmd.MarkFlagRequired("name")
+	runListCmd.Flags().Bool("needs-confirmation", false, "List Runs waiting for confirmation across the Organization (optional)")
+	runListCmd.Flags().Bool("interactive", false, "Review the plan of each Run waiting for confirmation and apply it (optional)")
 
 	// `tfx workspace run create` command
 	runCreateCmd.Flags().StringP("name", "n", "", "Workspace name")
 	// runCreateCmd.Flags().StringP("directory", "d", "./", "Directory of Terraform (defaults to current directory)")
 	runCreateCmd.Flags().StringP("message", "m", "", "Run Message (optional)")
 	runCreateCmd.Flags().StringP("configuration-version-id", "i", "", "Configuration Version (optional)")
+	runCreateCmd.Flags().Bool("wait", false, "Wait for the Run to finish, streaming logs and exiting with a code for the outcome (optional)")
+	runCreateCmd.Flags().Duration("timeout", 0, "Maximum time to wait with --wait, i.e. 30m (optional)")
 	runCreateCmd.MarkFlagRequired("name")
 
 	// `tfx workspace run show` command
 	runShowCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
 	runShowCmd.MarkFlagRequired("id")
 
+	// `tfx workspace run watch` command
+	runWatchCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runWatchCmd.Flags().Duration("timeout", 0, "Maximum time to wait, i.e. 30m (optional)")
+	runWatchCmd.MarkFlagRequired("id")
+
+	// `tfx workspace run timeline` command
+	runTimelineCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runTimelineCmd.MarkFlagRequired("id")
+
+	// `tfx workspace run cost` command
+	runCostCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runCostCmd.MarkFlagRequired("id")
+
+	// `tfx workspace run logs` command
+	runLogsCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runLogsCmd.Flags().StringP("output", "o", "", "Directory or .tar.gz file to write to (optional, defaults to a directory named after the Run)")
+	runLogsCmd.MarkFlagRequired("id")
+
+	// `tfx workspace run apply` command
+	runApplyCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runApplyCmd.Flags().StringP("comment", "c", "", "Comment to add when applying (optional)")
+	runApplyCmd.Flags().Bool("interactive", false, "Show the plan summary and ask for confirmation before applying (optional)")
+	runApplyCmd.MarkFlagRequired("id")
+
+	// `tfx workspace run comment` command
+	runCommentCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runCommentCmd.Flags().StringP("body", "b", "", "Comment text")
+	runCommentCmd.MarkFlagRequired("id")
+	runCommentCmd.MarkFlagRequired("body")
+
 	// `tfx workspace run discard` command
 	runDiscardCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
 	runDiscardCmd.MarkFlagRequired("id")
@@ -138,19 +328,36 @@ func init() {
 	runPolicyCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
 	runPolicyCmd.Flags().StringP("name", "n", "", "Workspace name (uses latest run)")
 	runPolicyCmd.Flags().BoolP("logs", "l", false, "Include raw Sentinel policy check logs")
+	runPolicyCmd.Flags().String("format", "table", fmt.Sprintf("Output format (%s)", strings.Join(flags.PolicyFormats, ", ")))
 	runPolicyCmd.MarkFlagsMutuallyExclusive("id", "name")
 	runPolicyCmd.MarkFlagsOneRequired("id", "name")
 
+	// `tfx workspace run policy override` command
+	runPolicyOverrideCmd.Flags().StringP("id", "i", "", "Run Id (i.e. run-*)")
+	runPolicyOverrideCmd.Flags().StringP("comment", "c", "", "Justification for the override, posted as a Run comment")
+	runPolicyOverrideCmd.MarkFlagRequired("id")
+	runPolicyOverrideCmd.MarkFlagRequired("comment")
+
 	workspaceCmd.AddCommand(runCmd)
 	runCmd.AddCommand(runListCmd)
 	runCmd.AddCommand(runCreateCmd)
 	runCmd.AddCommand(runShowCmd)
+	runCmd.AddCommand(runWatchCmd)
+	runCmd.AddCommand(runTimelineCmd)
+	runCmd.AddCommand(runCostCmd)
+	runCmd.AddCommand(runLogsCmd)
+	runCmd.AddCommand(runApplyCmd)
+	runCmd.AddCommand(runCommentCmd)
 	runCmd.AddCommand(runDiscardCmd)
 	runCmd.AddCommand(runCancelCmd)
 	runCmd.AddCommand(runPolicyCmd)
+	runPolicyCmd.AddCommand(runPolicyOverrideCmd)
 }
 
 func runList(cmdConfig *flags.RunListFlags) error {
+	if cmdConfig.NeedsConfirmation {
+		return runListNeedsConfirmation(cmdConfig)
+	}
 	v := view.NewRunListView()
 
 	c, err := client.NewFromViper()
@@ -173,6 +380,87 @@ func runList(cmdConfig *flags.RunListFlags) error {
 	return v.Render(runs)
 }
 
+func runListNeedsConfirmation(cmdConfig *flags.RunListFlags) error {
+	v := view.NewRunConfirmationListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+	if cmdConfig.Interactive && v.IsJSON() {
+		return v.RenderError(errors.New("--interactive cannot be used with --json"))
+	}
+
+	var workspaceNames []string
+	if cmdConfig.WorkspaceName != "" {
+		v.PrintCommandHeader("Listing runs needing confirmation for workspace '%s'", cmdConfig.WorkspaceName)
+		workspaceNames = []string{cmdConfig.WorkspaceName}
+	} else {
+		v.PrintCommandHeader("Listing runs needing confirmation in organization '%s'", c.OrganizationName)
+	}
+
+	runs, err := data.FetchRunsNeedingConfirmation(c, c.OrganizationName, workspaceNames)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list runs"))
+	}
+
+	if err := v.Render(runs); err != nil {
+		return err
+	}
+	if !cmdConfig.Interactive {
+		return nil
+	}
+
+	av := view.NewRunApplyView()
+	for _, run := range runs {
+		workspaceName := ""
+		if run.Workspace != nil {
+			workspaceName = run.Workspace.Name
+		}
+
+		av.Output().Message("")
+		applied, quit, err := reviewAndApplyRun(c, av, run, workspaceName, "", "[y]es, [n]o or [q]uit:")
+		if err != nil {
+			return av.RenderError(err)
+		}
+		if quit {
+			return nil
+		}
+		if !applied {
+			av.RenderSkipped(run.ID)
+		}
+	}
+	return nil
+}
+
+// reviewAndApplyRun shows the plan of a run and applies it once confirmed. quit is true when
+// the answer was q or quit.
+func reviewAndApplyRun(c *client.TfxClient, v *view.RunApplyView, run *tfe.Run, workspaceName string, comment string, choices string) (applied bool, quit bool, err error) {
+	summary, err := data.FetchRunPlanSummary(c, run, workspaceName)
+	if err != nil {
+		return false, false, errors.Wrap(err, "failed to read plan")
+	}
+	if err := v.RenderSummary(summary); err != nil {
+		return false, false, err
+	}
+
+	answer, err := prompt(fmt.Sprintf("Apply run '%s' in workspace '%s'? %s", run.ID, workspaceName, choices))
+	if err != nil {
+		return false, false, err
+	}
+	if answer == "q" || answer == "quit" {
+		return false, true, nil
+	}
+	if !isYes(answer) {
+		return false, false, nil
+	}
+
+	if err := data.ApplyRun(c, run.ID, comment); err != nil {
+		return false, false, errors.Wrap(err, "failed to apply run")
+	}
+	return true, false, v.Render(run.ID)
+}
+
 func runCreate(cmdConfig *flags.RunCreateFlags) error {
 	v := view.NewRunCreateView()
 
@@ -185,13 +473,114 @@ func runCreate(cmdConfig *flags.RunCreateFlags) error {
 
 	run, err := data.CreateRun(c, c.OrganizationName, cmdConfig.WorkspaceName, cmdConfig.Message, cmdConfig.ConfigurationVersionID)
 	if err != nil {
-		return v.RenderError(errors.Wrap(err, "failed to create run"))
+		err = errors.Wrap(err, "failed to create run")
+		if cmdConfig.Wait {
+			return renderWatchError(v.BaseView, err)
+		}
+		return v.RenderError(err)
 	}
 
 	// Build link to run
-	link := fmt.Sprintf("https://%s/app/%s/workspaces/%s/runs/%s", c.Hostname, c.OrganizationName, cmdConfig.WorkspaceName, run.ID)
+	link := runLink(c, cmdConfig.WorkspaceName, run.ID)
 
-	return v.Render(run, link)
+	if !cmdConfig.Wait {
+		return v.Render(run, link)
+	}
+
+	// In JSON mode only the final summary is rendered so the output stays a single document
+	if !v.IsJSON() {
+		if err := v.Render(run, link); err != nil {
+			return err
+		}
+	}
+	return watchRun(c, run.ID, cmdConfig.WorkspaceName, cmdConfig.Timeout)
+}
+
+func runWatch(cmdConfig *flags.RunWatchFlags) error {
+	v := view.NewRunWatchView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return renderWatchError(v.BaseView, err)
+	}
+
+	v.PrintCommandHeader("Watching run '%s'", cmdConfig.ID)
+
+	run, err := data.FetchRun(c, cmdConfig.ID)
+	if err != nil {
+		return renderWatchError(v.BaseView, errors.Wrap(err, "failed to read run from id"))
+	}
+
+	workspace, err := c.Client.Workspaces.ReadByID(c.Context, run.Workspace.ID)
+	if err != nil {
+		return renderWatchError(v.BaseView, errors.Wrap(err, "failed to read workspace"))
+	}
+
+	return watchRun(c, run.ID, workspace.Name, cmdConfig.Timeout)
+}
+
+// watchRun waits for a run to finish, streaming its logs outside of JSON mode, then renders
+// a summary and returns an error carrying the exit code for the run's outcome
+func watchRun(c *client.TfxClient, runID string, workspaceName string, timeout time.Duration) error {
+	v := view.NewRunWatchView()
+
+	if timeout > 0 {
+		ctx, cancel := context.WithTimeout(c.Context, timeout)
+		defer cancel()
+		c.Context = ctx
+	}
+
+	var onLine func(string)
+	if !v.IsJSON() {
+		v.Output().Message("")
+		onLine = v.RenderLogLine
+	}
+
+	run, outcome, err := data.WatchRun(c, runID, onLine)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return renderWatchError(v.BaseView, fmt.Errorf("timed out after %s waiting for run '%s'", timeout, runID))
+	}
+	if err != nil {
+		return renderWatchError(v.BaseView, errors.Wrap(err, "failed to watch run"))
+	}
+
+	summary := view.RunWatchSummary{
+		RunID:         run.ID,
+		WorkspaceName: workspaceName,
+		Status:        string(run.Status),
+		Outcome:       outcome,
+		ExitCode:      runOutcomeExitCodes[outcome],
+		HasChanges:    run.HasChanges,
+		Link:          runLink(c, workspaceName, run.ID),
+	}
+	if run.Plan != nil {
+		plan, err := data.FetchPlan(c, run.Plan.ID)
+		if err != nil {
+			return renderWatchError(v.BaseView, errors.Wrap(err, "failed to read plan"))
+		}
+		summary.Additions = plan.ResourceAdditions
+		summary.Changes = plan.ResourceChanges
+		summary.Destructions = plan.ResourceDestructions
+	}
+
+	if err := v.Render(summary); err != nil {
+		return err
+	}
+	return exitWithCode(summary.ExitCode)
+}
+
+// renderWatchError renders an error while waiting for a run and exits with the errored code,
+// so CI never mistakes a failure to watch for success
+func renderWatchError(v *view.BaseView, err error) error {
+	if renderErr := v.RenderError(err); renderErr != nil {
+		return renderErr
+	}
+	return exitWithCode(runOutcomeExitCodes[view.RunOutcomeErrored])
+}
+
+// runLink builds the link to a run in the UI
+func runLink(c *client.TfxClient, workspaceName string, runID string) string {
+	return fmt.Sprintf("https://%s/app/%s/workspaces/%s/runs/%s", c.Hostname, c.OrganizationName, workspaceName, runID)
 }
 
 func runShow(cmdConfig *flags.RunShowFlags) error {
@@ -209,7 +598,133 @@ func runShow(cmdConfig *flags.RunShowFlags) error {
 		return v.RenderError(errors.Wrap(err, "failed to read run from id"))
 	}
 
-	return v.Render(run)
+	taskResults := data.FetchRunTaskResults(c, run.ID)
+	return v.Render(run, view.NewTaskResultDetails(taskResults))
+}
+
+func runTimeline(cmdConfig *flags.RunTimelineFlags) error {
+	v := view.NewRunTimelineView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing timeline for run '%s'", cmdConfig.ID)
+
+	run, policyChecks, err := data.FetchRunForTimeline(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read run from id"))
+	}
+
+	return v.Render(run, runtimeline.Build(run, policyChecks, time.Now()))
+}
+
+func runCost(cmdConfig *flags.RunCostFlags) error {
+	v := view.NewRunCostView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Showing cost estimate for run '%s'", cmdConfig.ID)
+
+	result, err := data.FetchRunCostEstimate(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read cost estimate"))
+	}
+	return v.Render(result)
+}
+
+func runLogs(cmdConfig *flags.RunLogsFlags) error {
+	v := view.NewRunLogsView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	path := cmdConfig.Output
+	if path == "" {
+		path = cmdConfig.ID
+	}
+	v.PrintCommandHeader("Downloading logs for run '%s' to '%s'", cmdConfig.ID, path)
+
+	bundle, err := data.FetchRunLogBundle(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read run from id"))
+	}
+
+	path, err = pkgfile.WriteBundle(path, bundle.Files)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to write run logs"))
+	}
+
+	files := make([]view.RunLogsFile, len(bundle.Files))
+	for i, f := range bundle.Files {
+		files[i] = view.RunLogsFile{Name: f.Name, Size: len(f.Content)}
+	}
+	return v.Render(bundle.Run.ID, path, files, bundle.Skipped)
+}
+
+func runApply(cmdConfig *flags.RunApplyFlags) error {
+	v := view.NewRunApplyView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+	if cmdConfig.Interactive && v.IsJSON() {
+		return v.RenderError(errors.New("--interactive cannot be used with --json"))
+	}
+
+	v.PrintCommandHeader("Applying run '%s'", cmdConfig.ID)
+
+	if !cmdConfig.Interactive {
+		if err := data.ApplyRun(c, cmdConfig.ID, cmdConfig.Comment); err != nil {
+			return v.RenderError(errors.Wrap(err, "failed to apply run"))
+		}
+		return v.Render(cmdConfig.ID)
+	}
+
+	run, err := data.FetchRun(c, cmdConfig.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read run from id"))
+	}
+	if run.Actions == nil || !run.Actions.IsConfirmable {
+		return v.RenderError(fmt.Errorf("run '%s' is not waiting for confirmation (status: %s)", run.ID, run.Status))
+	}
+	workspace, err := c.Client.Workspaces.ReadByID(c.Context, run.Workspace.ID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	applied, _, err := reviewAndApplyRun(c, v, run, workspace.Name, cmdConfig.Comment, "[y]es or [n]o:")
+	if err != nil {
+		return v.RenderError(err)
+	}
+	if !applied {
+		v.RenderSkipped(run.ID)
+	}
+	return nil
+}
+
+func runComment(cmdConfig *flags.RunCommentFlags) error {
+	v := view.NewRunCommentView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Adding comment to run '%s'", cmdConfig.ID)
+
+	comment, err := data.CreateRunComment(c, cmdConfig.ID, cmdConfig.Body)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to create comment"))
+	}
+	return v.Render(cmdConfig.ID, comment)
 }
 
 func runDiscard(cmdConfig *flags.RunDiscardFlags) error {
@@ -259,32 +774,92 @@ func runCancel(cmdConfig *flags.RunCancelFlags) error {
 func runPolicy(cmdConfig *flags.RunPolicyFlags) error {
 	v := view.NewRunPolicyView()
 
+	// Reports go to stdout to be redirected, so only print a header for the table
+	header := cmdConfig.Format == "table"
+	report := !v.IsJSON() && !header
+
 	c, err := client.NewFromViper()
 	if err != nil {
-		return v.RenderError(err)
+		return renderPolicyReportError(v, report, err)
 	}
 
 	runID := cmdConfig.ID
 	if runID == "" {
-		v.PrintCommandHeader("Fetching policy details for latest run in workspace '%s'", cmdConfig.WorkspaceName)
+		if header {
+			v.PrintCommandHeader("Fetching policy details for latest run in workspace '%s'", cmdConfig.WorkspaceName)
+		}
 
 		workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
 		if err != nil {
-			return v.RenderError(errors.Wrap(err, "unable to read workspace id"))
+			return renderPolicyReportError(v, report, errors.Wrap(err, "unable to read workspace id"))
 		}
 
 		runID, err = data.GetLatestRunID(c, workspaceID)
 		if err != nil {
-			return v.RenderError(errors.Wrap(err, "failed to get latest run id"))
+			return renderPolicyReportError(v, report, errors.Wrap(err, "failed to get latest run id"))
 		}
-	} else {
+	} else if header {
 		v.PrintCommandHeader("Fetching policy details for run '%s'", runID)
 	}
 
 	result, err := data.FetchRunPolicyDetails(c, runID, cmdConfig.Logs)
 	if err != nil {
-		return v.RenderError(errors.Wrap(err, "failed to fetch policy details"))
+		return renderPolicyReportError(v, report, errors.Wrap(err, "failed to fetch policy details"))
+	}
+
+	switch {
+	case v.IsJSON() || cmdConfig.Format == "table":
+		return v.Render(result)
+	case cmdConfig.Format == "junit":
+		err = v.RenderJUnit(result)
+	default:
+		err = v.RenderSARIF(result)
+	}
+	if err != nil {
+		return renderPolicyReportError(v, report, errors.Wrap(err, "failed to write policy report"))
+	}
+
+	// Only failures that can not be overridden and errored policies fail the build
+	cases := view.PolicyTestCases(result)
+	if view.HardMandatoryFailures(cases) > 0 || view.ErroredPolicies(cases) > 0 {
+		return exitWithCode(2)
+	}
+	return nil
+}
+
+// renderPolicyReportError renders an error for the policy command. A report is read by CI, so
+// it exits with code 1 rather than leaving a failure to read the policies looking like a pass.
+func renderPolicyReportError(v *view.RunPolicyView, report bool, err error) error {
+	if renderErr := v.RenderError(err); renderErr != nil {
+		return renderErr
+	}
+	if report {
+		return exitWithCode(1)
+	}
+	return nil
+}
+
+func runPolicyOverride(cmdConfig *flags.RunPolicyOverrideFlags) error {
+	v := view.NewRunPolicyOverrideView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
 	}
 
+	v.PrintCommandHeader("Overriding policy failures for run '%s'", cmdConfig.ID)
+
+	result, err := data.OverrideRunPolicies(c, cmdConfig.ID, cmdConfig.Comment)
+	if err != nil && result == nil {
+		return v.RenderError(errors.Wrap(err, "failed to override policies"))
+	}
+	if err != nil {
+		// The comment was posted, so show what was overridden before the failure
+		result.Error = errors.Wrap(err, "failed to override policies").Error()
+		if renderErr := v.Render(result); renderErr != nil {
+			return renderErr
+		}
+		return exitWithCode(1)
+	}
 	return v.Render(result)
 }
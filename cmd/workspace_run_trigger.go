@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package cmd
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/data"
+)
+
+var (
+	// `tfx workspace run-trigger` commands
+	workspaceRunTriggerCmd = &cobra.Command{
+		Use:   "run-trigger",
+		Short: "Run Trigger Commands",
+		Long:  "Manage the source Workspaces whose applies queue runs in a Workspace.",
+	}
+
+	// `tfx workspace run-trigger list` command
+	workspaceRunTriggerListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List Run Triggers",
+		Long:  "List the source Workspaces that queue runs in a Workspace, or with --direction outbound the Workspaces it queues runs in.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTriggerListFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRunTriggerList(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run-trigger add` command
+	workspaceRunTriggerAddCmd = &cobra.Command{
+		Use:   "add",
+		Short: "Add Run Triggers",
+		Long:  "Queue runs in a Workspace whenever one of the source Workspaces applies.",
+		Example: `
+tfx workspace run-trigger add --name app-prod --source-name network-prod,dns-prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTriggerChangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRunTriggerAdd(cmdConfig)
+		},
+	}
+
+	// `tfx workspace run-trigger remove` command
+	workspaceRunTriggerRemoveCmd = &cobra.Command{
+		Use:   "remove",
+		Short: "Remove Run Triggers",
+		Long:  "Stop source Workspaces from queueing runs in a Workspace.",
+		Example: `
+tfx workspace run-trigger remove --name app-prod --source-name dns-prod`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseRunTriggerChangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceRunTriggerRemove(cmdConfig)
+		},
+	}
+)
+
+func init() {
+	// `tfx workspace run-trigger list`
+	workspaceRunTriggerListCmd.Flags().StringP("name", "n", "", "Name of the Workspace.")
+	workspaceRunTriggerListCmd.Flags().String("direction", "inbound", "inbound lists sources that trigger the Workspace, outbound lists Workspaces it triggers (optional).")
+	workspaceRunTriggerListCmd.MarkFlagRequired("name")
+
+	// `tfx workspace run-trigger add` and `remove`
+	for _, cmd := range []*cobra.Command{workspaceRunTriggerAddCmd, workspaceRunTriggerRemoveCmd} {
+		cmd.Flags().StringP("name", "n", "", "Name of the Workspace runs are queued in.")
+		cmd.Flags().StringSlice("source-name", []string{}, "Source Workspace name, can be supplied multiple times or comma separated.")
+		cmd.MarkFlagRequired("name")
+		cmd.MarkFlagRequired("source-name")
+	}
+
+	workspaceCmd.AddCommand(workspaceRunTriggerCmd)
+	workspaceRunTriggerCmd.AddCommand(workspaceRunTriggerListCmd)
+	workspaceRunTriggerCmd.AddCommand(workspaceRunTriggerAddCmd)
+	workspaceRunTriggerCmd.AddCommand(workspaceRunTriggerRemoveCmd)
+}
+
+func workspaceRunTriggerList(cmdConfig *flags.RunTriggerListFlags) error {
+	v := view.NewRunTriggerListView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Listing %s run triggers for workspace '%s' in organization '%s'", cmdConfig.Direction, cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	triggers, err := data.FetchRunTriggers(c, workspaceID, tfe.RunTriggerFilterOp(cmdConfig.Direction))
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list run triggers"))
+	}
+
+	return v.Render(triggers)
+}
+
+func workspaceRunTriggerAdd(cmdConfig *flags.RunTriggerChangeFlags) error {
+	v := view.NewRunTriggerChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Adding run triggers for workspace '%s' in organization '%s'", cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	sources, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, data.WorkspaceSelector{Names: cmdConfig.SourceNames})
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read source workspaces"))
+	}
+
+	results, err := data.AddRunTriggers(c, workspace, sources)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to add run triggers"))
+	}
+	return v.Render(results)
+}
+
+func workspaceRunTriggerRemove(cmdConfig *flags.RunTriggerChangeFlags) error {
+	v := view.NewRunTriggerChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Removing run triggers for workspace '%s' in organization '%s'", cmdConfig.WorkspaceName, c.OrganizationName)
+
+	workspace, err := data.FetchWorkspace(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to read workspace"))
+	}
+
+	results, err := data.RemoveRunTriggers(c, workspace, cmdConfig.SourceNames)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to remove run triggers"))
+	}
+	return v.Render(results)
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/straubt1/tfx/client"
@@ -33,6 +34,59 @@ var (
 			return workspaceTeamList(cmdConfig)
 		},
 	}
+
+	// `tfx workspace team add` command
+	workspaceTeamAddCmd = &cobra.Command{
+		Use:   "add",
+		Short: "Add a Team to Workspaces",
+		Long:  "Grant a Team access to one or more Workspaces, using a fixed access level or a custom set of permissions.",
+		Example: `
+Give a team write access to a workspace:
+tfx workspace team add --name my-workspace --team-name app-team --access write
+
+Give a team custom access to every workspace with a tag:
+tfx workspace team add --tags team:app --team-name app-team --access custom --runs plan --variables read --state-versions read-outputs`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseTeamAccessFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceTeamAdd(cmdConfig)
+		},
+	}
+
+	// `tfx workspace team update` command
+	workspaceTeamUpdateCmd = &cobra.Command{
+		Use:   "update",
+		Short: "Update a Team's access to Workspaces",
+		Long:  "Replace the access a Team has on one or more Workspaces. Workspaces the Team has no access to are skipped.",
+		Example: `
+Downgrade a team to read access across a project:
+tfx workspace team update --project-name platform --team-name contractors --access read`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseTeamAccessFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceTeamUpdate(cmdConfig)
+		},
+	}
+
+	// `tfx workspace team remove` command
+	workspaceTeamRemoveCmd = &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a Team from Workspaces",
+		Long:  "Remove a Team's access from one or more Workspaces. Workspaces the Team has no access to are skipped.",
+		Example: `
+tfx workspace team remove --wildcard-name "legacy-*" --team-name old-team`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseTeamRemoveFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return workspaceTeamRemove(cmdConfig)
+		},
+	}
 )
 
 func init() {
@@ -40,8 +94,85 @@ func init() {
 	workspaceTeamListCmd.Flags().StringP("name", "n", "", "Name of the Workspace")
 	workspaceTeamListCmd.MarkFlagRequired("name")
 
+	// `tfx workspace team add` and `tfx workspace team update` command flags
+	for _, cmd := range []*cobra.Command{workspaceTeamAddCmd, workspaceTeamUpdateCmd} {
+		addWorkspaceSelectorFlags(cmd, "project-name")
+		addTeamAccessFlags(cmd)
+		cmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to change concurrently (optional).")
+		cmd.MarkFlagRequired("team-name")
+		cmd.MarkFlagRequired("access")
+	}
+
+	// `tfx workspace team remove` command flags
+	addWorkspaceSelectorFlags(workspaceTeamRemoveCmd, "project-name")
+	workspaceTeamRemoveCmd.Flags().String("team-name", "", "Name of the Team.")
+	workspaceTeamRemoveCmd.Flags().Int("parallelism", data.DefaultParallelism, "Number of Workspaces to change concurrently (optional).")
+	workspaceTeamRemoveCmd.MarkFlagRequired("team-name")
+
 	workspaceCmd.AddCommand(workspaceTeamCmd)
 	workspaceTeamCmd.AddCommand(workspaceTeamListCmd)
+	workspaceTeamCmd.AddCommand(workspaceTeamAddCmd)
+	workspaceTeamCmd.AddCommand(workspaceTeamUpdateCmd)
+	workspaceTeamCmd.AddCommand(workspaceTeamRemoveCmd)
+}
+
+func addTeamAccessFlags(cmd *cobra.Command) {
+	cmd.Flags().String("team-name", "", "Name of the Team.")
+	cmd.Flags().String("access", "", "Access level: read, plan, write, admin or custom.")
+	cmd.Flags().String("runs", "", "Custom access to runs: read, plan or apply (optional).")
+	cmd.Flags().String("variables", "", "Custom access to variables: none, read or write (optional).")
+	cmd.Flags().String("state-versions", "", "Custom access to state versions: none, read-outputs, read or write (optional).")
+	cmd.Flags().String("sentinel-mocks", "", "Custom access to Sentinel mocks: none or read (optional).")
+	cmd.Flags().Bool("workspace-locking", false, "Custom permission to lock and unlock the Workspace (optional).")
+	cmd.Flags().Bool("run-tasks", false, "Custom permission to manage run tasks (optional).")
+}
+
+func teamAccessParamsFromFlags(f *flags.TeamAccessFlags) data.TeamAccessParams {
+	return data.TeamAccessParams{
+		Access:           f.Access,
+		Runs:             f.Runs,
+		Variables:        f.Variables,
+		StateVersions:    f.StateVersions,
+		SentinelMocks:    f.SentinelMocks,
+		WorkspaceLocking: f.WorkspaceLocking,
+		RunTasks:         f.RunTasks,
+	}
+}
+
+// renderTeamAccessResults renders the result for each workspace and exits with code 1 when any
+// change failed
+func renderTeamAccessResults(v *view.TeamAccessChangeView, results []view.TeamAccessResult) error {
+	if err := v.Render(results); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
+
+// resolveTeamAccessTargets resolves the team and the selected workspaces for a team access change
+func resolveTeamAccessTargets(c *client.TfxClient, teamName string, f flags.WorkspaceSelectorFlags) (*tfe.Team, []*tfe.Workspace, error) {
+	selector := workspaceSelectorFromFlags(f)
+	if selector.IsEmpty() {
+		return nil, nil, errors.New("at least one workspace selector is required (--name, --search, --wildcard-name, --tags, --exclude-tags or --project-name)")
+	}
+
+	team, err := data.FetchTeamByName(c, c.OrganizationName, teamName)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read team")
+	}
+
+	workspaces, err := data.FetchWorkspacesBySelector(c, c.OrganizationName, selector)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list workspaces")
+	}
+	if len(workspaces) == 0 {
+		return nil, nil, errors.New("no workspaces matched the selector")
+	}
+	return team, workspaces, nil
 }
 
 func workspaceTeamList(cmdConfig *flags.TeamListFlags) error {
@@ -84,3 +215,63 @@ func workspaceTeamList(cmdConfig *flags.TeamListFlags) error {
 
 	return v.Render(teamAccess, names)
 }
+
+func workspaceTeamAdd(cmdConfig *flags.TeamAccessFlags) error {
+	v := view.NewTeamAccessChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Adding team '%s' with '%s' access in organization '%s'", cmdConfig.TeamName, cmdConfig.Access, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	team, workspaces, err := resolveTeamAccessTargets(c, cmdConfig.TeamName, cmdConfig.WorkspaceSelectorFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	results := data.AddTeamAccess(c, workspaces, team, teamAccessParamsFromFlags(cmdConfig), cmdConfig.Parallelism)
+	return renderTeamAccessResults(v, results)
+}
+
+func workspaceTeamUpdate(cmdConfig *flags.TeamAccessFlags) error {
+	v := view.NewTeamAccessChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Updating team '%s' to '%s' access in organization '%s'", cmdConfig.TeamName, cmdConfig.Access, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	team, workspaces, err := resolveTeamAccessTargets(c, cmdConfig.TeamName, cmdConfig.WorkspaceSelectorFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	results := data.UpdateTeamAccess(c, workspaces, team, teamAccessParamsFromFlags(cmdConfig), cmdConfig.Parallelism)
+	return renderTeamAccessResults(v, results)
+}
+
+func workspaceTeamRemove(cmdConfig *flags.TeamRemoveFlags) error {
+	v := view.NewTeamAccessChangeView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Removing team '%s' in organization '%s'", cmdConfig.TeamName, c.OrganizationName)
+	printWorkspaceSelector(v.BaseView, cmdConfig.WorkspaceSelectorFlags)
+
+	team, workspaces, err := resolveTeamAccessTargets(c, cmdConfig.TeamName, cmdConfig.WorkspaceSelectorFlags)
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	results := data.RemoveTeamAccess(c, workspaces, team, cmdConfig.Parallelism)
+	return renderTeamAccessResults(v, results)
+}
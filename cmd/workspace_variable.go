@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -12,6 +14,7 @@ import (
 	view "github.com/straubt1/tfx/cmd/views"
 	"github.com/straubt1/tfx/data"
 	pkgfile "github.com/straubt1/tfx/pkg/file"
+	"github.com/straubt1/tfx/pkg/varfile"
 )
 
 var (
@@ -94,6 +97,34 @@ var (
 			return variableDelete(cmdConfig)
 		},
 	}
+
+	// `tfx variable sync` command
+	variableSyncCmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Sync Variables from files",
+		Long: `Sync the Variables of a Workspace with a Terraform variables file (.tfvars, or JSON for a .json
+file) and a dotenv file of Environment Variables. The changes are shown and applied once you
+confirm them, use --auto-approve to apply them without asking, such as in CI.
+
+Variables are matched by key and category. Sensitive values are never shown, and since they can
+not be read a sensitive Variable in the files is always updated. With --prune, Variables missing
+from the files are deleted, except sensitive Variables so secrets can be kept out of the files.
+Only the categories of the files given are pruned, Terraform variables with --file and
+Environment Variables with --env-file.`,
+		Example: `
+Preview the changes:
+tfx workspace variable sync --name app-prod --file prod.tfvars --env-file prod.env --dry-run
+
+Apply them without asking and delete variables not in the files:
+tfx workspace variable sync --name app-prod --file prod.tfvars --env-file prod.env --prune --auto-approve`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdConfig, err := flags.ParseVariableSyncFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return variableSync(cmdConfig)
+		},
+	}
 )
 
 func init() {
@@ -141,12 +172,24 @@ func init() {
 	variableDeleteCmd.MarkFlagRequired("name")
 	variableDeleteCmd.MarkFlagRequired("key")
 
+	// `tfx variable sync` command
+	variableSyncCmd.Flags().StringP("name", "n", "", "Name of the Workspace")
+	variableSyncCmd.Flags().StringP("file", "f", "", "Path to a Terraform variables file, .tfvars or .json (file or env-file must be set)")
+	variableSyncCmd.Flags().String("env-file", "", "Path to a dotenv file of Environment Variables (file or env-file must be set)")
+	variableSyncCmd.Flags().Bool("prune", false, "Delete Variables that are not in the files, except sensitive Variables (optional, defaults to false)")
+	variableSyncCmd.Flags().Bool("dry-run", false, "Show the changes without applying them (optional, defaults to false)")
+	variableSyncCmd.Flags().Bool("auto-approve", false, "Apply the changes without asking for confirmation, required with --json (optional, defaults to false)")
+	variableSyncCmd.MarkFlagRequired("name")
+	variableSyncCmd.MarkFlagsOneRequired("file", "env-file")
+	variableSyncCmd.MarkFlagsMutuallyExclusive("dry-run", "auto-approve")
+
 	workspaceCmd.AddCommand(variableCmd)
 	variableCmd.AddCommand(variableListCmd)
 	variableCmd.AddCommand(variableCreateCmd)
 	variableCmd.AddCommand(variableUpdateCmd)
 	variableCmd.AddCommand(variableShowCmd)
 	variableCmd.AddCommand(variableDeleteCmd)
+	variableCmd.AddCommand(variableSyncCmd)
 }
 
 func variableList(cmdConfig *flags.VariableListFlags) error {
@@ -336,3 +379,96 @@ func variableDelete(cmdConfig *flags.VariableDeleteFlags) error {
 
 	return v.Render(cmdConfig.Key)
 }
+
+func variableSync(cmdConfig *flags.VariableSyncFlags) error {
+	// Create view for rendering
+	v := view.NewVariableSyncView()
+
+	c, err := client.NewFromViper()
+	if err != nil {
+		return v.RenderError(err)
+	}
+
+	v.PrintCommandHeader("Syncing variables for workspace '%s'", cmdConfig.WorkspaceName)
+
+	var desired []varfile.Variable
+	if cmdConfig.File != "" {
+		v.PrintCommandFilter("Terraform variables: %s", cmdConfig.File)
+		vars, err := varfile.ReadFile(cmdConfig.File)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "unable to read the variables file"))
+		}
+		desired = append(desired, vars...)
+	}
+	if cmdConfig.EnvFile != "" {
+		v.PrintCommandFilter("Environment variables: %s", cmdConfig.EnvFile)
+		vars, err := varfile.ReadEnvFile(cmdConfig.EnvFile)
+		if err != nil {
+			return v.RenderError(errors.Wrap(err, "unable to read the env file"))
+		}
+		desired = append(desired, vars...)
+	}
+
+	workspaceID, err := data.GetWorkspaceID(c, c.OrganizationName, cmdConfig.WorkspaceName)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "unable to read workspace id"))
+	}
+
+	existing, err := data.FetchVariables(c, workspaceID)
+	if err != nil {
+		return v.RenderError(errors.Wrap(err, "failed to list variables"))
+	}
+
+	// Only prune the categories of the files that were given, so --file alone never deletes
+	// Environment Variables and --env-file alone never deletes Terraform variables
+	var pruneCategories []string
+	if cmdConfig.Prune && cmdConfig.File != "" {
+		pruneCategories = append(pruneCategories, varfile.CategoryTerraform)
+	}
+	if cmdConfig.Prune && cmdConfig.EnvFile != "" {
+		pruneCategories = append(pruneCategories, varfile.CategoryEnv)
+	}
+
+	changes := data.PlanVariableSync(desired, existing, pruneCategories)
+	pending := false
+	for _, change := range changes {
+		if change.Pending() {
+			pending = true
+		}
+	}
+	if cmdConfig.DryRun || !pending {
+		return v.Render(cmdConfig.WorkspaceName, changes, cmdConfig.DryRun)
+	}
+
+	if !cmdConfig.AutoApprove {
+		// The question would break the JSON output, so JSON always needs --auto-approve
+		if v.IsJSON() {
+			if err := v.RenderError(errors.New("--auto-approve is required to apply changes with --json")); err != nil {
+				return err
+			}
+			return exitWithCode(1)
+		}
+		if err := v.Render(cmdConfig.WorkspaceName, changes, false); err != nil {
+			return err
+		}
+		answer, err := prompt(fmt.Sprintf("Apply these changes to workspace '%s'? [y]es or [n]o:", cmdConfig.WorkspaceName))
+		if err != nil {
+			return v.RenderError(err)
+		}
+		if !isYes(answer) {
+			v.Output().Message("Sync cancelled, no changes were made.")
+			return nil
+		}
+	}
+
+	changes = data.ApplyVariableSync(c, workspaceID, changes)
+	if err := v.Render(cmdConfig.WorkspaceName, changes, false); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if change.Failed() {
+			return exitWithCode(1)
+		}
+	}
+	return nil
+}
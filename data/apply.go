@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"io"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/output"
+)
+
+// FetchApply retrieves an apply by ID
+func FetchApply(c *client.TfxClient, applyID string) (*tfe.Apply, error) {
+	output.Get().Logger().Debug("Fetching apply by ID", "applyID", applyID)
+
+	apply, err := c.Client.Applies.Read(c.Context, applyID)
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch apply", "applyID", applyID, "error", err)
+		return nil, err
+	}
+
+	output.Get().Logger().Debug("Apply fetched successfully", "applyID", applyID, "status", apply.Status)
+	return apply, nil
+}
+
+// FetchApplyLogs retrieves logs for an apply by ID
+func FetchApplyLogs(c *client.TfxClient, applyID string) ([]string, error) {
+	output.Get().Logger().Debug("Fetching apply logs", "applyID", applyID)
+
+	logsReader, err := c.Client.Applies.Logs(c.Context, applyID)
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch apply logs", "applyID", applyID, "error", err)
+		return nil, err
+	}
+
+	logBytes, err := io.ReadAll(logsReader)
+	if err != nil {
+		output.Get().Logger().Error("Failed to read apply logs", "applyID", applyID, "error", err)
+		return nil, err
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(logBytes), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	output.Get().Logger().Debug("Apply logs fetched successfully", "applyID", applyID, "lineCount", len(lines))
+	return lines, nil
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"fmt"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/planjson"
+)
+
+// go-tfe does not cover health assessments, so the responses are decoded here
+
+type workspaceAssessmentRelationship struct {
+	Data struct {
+		Relationships struct {
+			CurrentAssessmentResult struct {
+				Data *struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			} `json:"current-assessment-result"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
+type assessmentResult struct {
+	Data struct {
+		ID         string `json:"id"`
+		Attributes struct {
+			Drifted          bool      `json:"drifted"`
+			Succeeded        bool      `json:"succeeded"`
+			ErrorMsg         *string   `json:"error-msg"`
+			CreatedAt        time.Time `json:"created-at"`
+			ResourcesDrifted int       `json:"resources-drifted"`
+			ChecksPassed     int       `json:"checks-passed"`
+			ChecksFailed     int       `json:"checks-failed"`
+			ChecksErrored    int       `json:"checks-errored"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// FetchWorkspaceAssessment reads the current assessment result of a workspace. When details is
+// true and the workspace drifted or has failing checks, the assessment's plan is read to list
+// the drifted resources and failed checks.
+func FetchWorkspaceAssessment(c *client.TfxClient, w *tfe.Workspace, details bool) (view.WorkspaceAssessment, error) {
+	output.Get().Logger().Debug("Fetching workspace assessment", "workspace", w.Name, "workspaceID", w.ID)
+
+	a := view.WorkspaceAssessment{
+		WorkspaceName:      w.Name,
+		WorkspaceID:        w.ID,
+		AssessmentsEnabled: w.AssessmentsEnabled,
+		DriftedResources:   []view.AssessmentDriftedResource{},
+		FailedChecks:       []view.AssessmentFailedCheck{},
+	}
+
+	var rel workspaceAssessmentRelationship
+	if err := fetchAPIJSON(c, fmt.Sprintf("/api/v2/workspaces/%s", w.ID), &rel); err != nil {
+		output.Get().Logger().Error("Failed to read workspace", "workspaceID", w.ID, "error", err)
+		return a, err
+	}
+	current := rel.Data.Relationships.CurrentAssessmentResult.Data
+	if current == nil || current.ID == "" {
+		// Assessments may be enforced by the organization, so a result can exist while disabled
+		a.Status = view.AssessmentNone
+		if !w.AssessmentsEnabled {
+			a.Status = view.AssessmentNotEnabled
+		}
+		return a, nil
+	}
+
+	var result assessmentResult
+	if err := fetchAPIJSON(c, fmt.Sprintf("/api/v2/assessment-results/%s", current.ID), &result); err != nil {
+		output.Get().Logger().Error("Failed to read assessment result", "assessmentResultID", current.ID, "error", err)
+		return a, errors.Wrap(err, "failed to read assessment result")
+	}
+
+	attrs := result.Data.Attributes
+	a.AssessmentResultID = current.ID
+	a.Drifted = attrs.Drifted
+	a.ResourcesDrifted = attrs.ResourcesDrifted
+	a.ChecksPassed = attrs.ChecksPassed
+	a.ChecksFailed = attrs.ChecksFailed
+	a.ChecksErrored = attrs.ChecksErrored
+	a.AssessedAt = &attrs.CreatedAt
+	if attrs.ErrorMsg != nil {
+		a.ErrorMessage = *attrs.ErrorMsg
+	}
+
+	switch {
+	case !attrs.Succeeded:
+		a.Status = view.AssessmentErrored
+	case attrs.Drifted:
+		a.Status = view.AssessmentDrifted
+	case attrs.ChecksFailed > 0 || attrs.ChecksErrored > 0:
+		a.Status = view.AssessmentChecksFailed
+	default:
+		a.Status = view.AssessmentNoDrift
+	}
+
+	if !details || !a.HasFindings() || a.Status == view.AssessmentErrored {
+		return a, nil
+	}
+
+	body, err := fetchAPI(c, fmt.Sprintf("/api/v2/assessment-results/%s/json-output", current.ID))
+	if err != nil {
+		output.Get().Logger().Error("Failed to read assessment plan", "assessmentResultID", current.ID, "error", err)
+		return a, errors.Wrap(err, "failed to read assessment plan, reading it requires admin access to the workspace")
+	}
+	plan, err := planjson.Parse(body)
+	if err != nil {
+		return a, errors.Wrap(err, "failed to parse assessment plan")
+	}
+	applyAssessmentPlan(&a, plan)
+	return a, nil
+}
+
+// applyAssessmentPlan lists the drifted resources and failed checks of an assessment's plan
+func applyAssessmentPlan(a *view.WorkspaceAssessment, plan *planjson.Plan) {
+	for _, r := range plan.DriftedResources() {
+		a.DriftedResources = append(a.DriftedResources, view.AssessmentDriftedResource{
+			Address: r.Address,
+			Action:  r.Change.Action(),
+		})
+	}
+	for _, check := range plan.FailedChecks() {
+		a.FailedChecks = append(a.FailedChecks, view.AssessmentFailedCheck{
+			Address:  check.Address.ToDisplay,
+			Kind:     check.Address.Kind,
+			Status:   check.Status,
+			Messages: check.Messages(),
+		})
+	}
+
+	// Older results do not report counts, fall back to what the plan contains
+	if a.ResourcesDrifted == 0 {
+		a.ResourcesDrifted = len(a.DriftedResources)
+	}
+	if a.ChecksFailed == 0 && a.ChecksErrored == 0 {
+		a.ChecksFailed = len(a.FailedChecks)
+	}
+}
+
+// FetchDriftReport reads the current assessment of every workspace concurrently. A workspace
+// whose details cannot be read is still reported, with the error recorded on it.
+func FetchDriftReport(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, details bool, parallelism int) ([]view.WorkspaceAssessment, error) {
+	output.Get().Logger().Debug("Building drift report", "organization", orgName, "count", len(workspaces), "parallelism", parallelism)
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		assessment view.WorkspaceAssessment
+		err        error
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		a, err := FetchWorkspaceAssessment(c, w, details)
+		return result{assessment: a, err: err}
+	})
+
+	assessments := make([]view.WorkspaceAssessment, len(workspaces))
+	for i, r := range results {
+		w := workspaces[i]
+		if r.err != nil && r.assessment.Status == "" {
+			return nil, errors.Wrapf(r.err, "failed to read assessment for %s", w.Name)
+		}
+		if r.err != nil && r.assessment.ErrorMessage == "" {
+			r.assessment.ErrorMessage = r.err.Error()
+		}
+		if w.Project != nil {
+			r.assessment.ProjectName = projectNames[w.Project.ID]
+		}
+		assessments[i] = r.assessment
+	}
+	return assessments, nil
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import "sync"
+
+// DefaultParallelism is the number of concurrent API calls made by bulk operations
+const DefaultParallelism = 5
+
+// forEachConcurrent calls fn for every item using at most parallelism goroutines.
+// Results are returned in the same order as items.
+func forEachConcurrent[T, R any](items []T, parallelism int, fn func(T) R) []R {
+	if parallelism < 1 {
+		parallelism = DefaultParallelism
+	}
+
+	results := make([]R, len(items))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = fn(item)
+		}(i, item)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -4,6 +4,8 @@
 package data
 
 import (
+	"time"
+
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/pkg/errors"
 	"github.com/straubt1/tfx/client"
@@ -86,6 +88,33 @@ func CreateConfigurationVersion(c *client.TfxClient, orgName, workspaceName, dir
 	return cv, nil
 }
 
+// WaitForConfigurationVersionUpload polls a configuration version until the uploaded code has
+// been processed, so a run can be created from it
+func WaitForConfigurationVersionUpload(c *client.TfxClient, configurationID string) (*tfe.ConfigurationVersion, error) {
+	output.Get().Logger().Debug("Waiting for configuration version upload", "configurationVersionID", configurationID)
+
+	delay := runPollMinDelay
+	for {
+		cv, err := c.Client.ConfigurationVersions.Read(c.Context, configurationID)
+		if err != nil {
+			return nil, err
+		}
+		switch cv.Status {
+		case tfe.ConfigurationUploaded:
+			return cv, nil
+		case tfe.ConfigurationErrored:
+			return nil, errors.Errorf("configuration version %s errored: %s", cv.ID, cv.ErrorMessage)
+		}
+
+		select {
+		case <-c.Context.Done():
+			return nil, c.Context.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, runPollMaxDelay)
+	}
+}
+
 // FetchConfigurationVersion reads a configuration version with includes
 func FetchConfigurationVersion(c *client.TfxClient, configurationID string) (*tfe.ConfigurationVersion, error) {
 	output.Get().Logger().Debug("Fetching configuration version", "configurationVersionID", configurationID)
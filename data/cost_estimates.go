@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"fmt"
+	"io"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/costestimate"
+)
+
+// costReportRunLookback is how many of the latest applied runs of a workspace are searched
+// for a finished cost estimate
+const costReportRunLookback = 20
+
+// FetchRunCostEstimate reads the cost estimate of a run, with the resource breakdown from
+// the cost estimate log
+func FetchRunCostEstimate(c *client.TfxClient, runID string) (*view.RunCostResult, error) {
+	log := output.Get().Logger()
+	log.Debug("Fetching cost estimate for run", "runID", runID)
+
+	run, err := c.Client.Runs.Read(c.Context, runID)
+	if err != nil {
+		log.Error("Failed to read run", "runID", runID, "error", err)
+		return nil, err
+	}
+	if run.CostEstimate == nil {
+		return nil, fmt.Errorf("run '%s' has no cost estimate, check that cost estimation is enabled for the organization", runID)
+	}
+
+	ce, err := c.Client.CostEstimates.Read(c.Context, run.CostEstimate.ID)
+	if err != nil {
+		log.Error("Failed to read cost estimate", "id", run.CostEstimate.ID, "error", err)
+		return nil, err
+	}
+
+	result := &view.RunCostResult{
+		RunID:                   runID,
+		CostEstimateID:          ce.ID,
+		Status:                  string(ce.Status),
+		ErrorMessage:            ce.ErrorMessage,
+		ResourcesCount:          ce.ResourcesCount,
+		MatchedResourcesCount:   ce.MatchedResourcesCount,
+		UnmatchedResourcesCount: ce.UnmatchedResourcesCount,
+		Resources:               []costestimate.Resource{},
+	}
+	if result.PriorMonthlyCost, err = costestimate.ParseAmount(ce.PriorMonthlyCost); err != nil {
+		return nil, err
+	}
+	if result.ProposedMonthlyCost, err = costestimate.ParseAmount(ce.ProposedMonthlyCost); err != nil {
+		return nil, err
+	}
+	if result.DeltaMonthlyCost, err = costestimate.ParseAmount(ce.DeltaMonthlyCost); err != nil {
+		return nil, err
+	}
+	// Only a finished estimate has a resource breakdown
+	if ce.Status != tfe.CostEstimateFinished {
+		return result, nil
+	}
+
+	logReader, err := c.Client.CostEstimates.Logs(c.Context, ce.ID)
+	if err != nil {
+		log.Error("Failed to fetch cost estimate logs", "id", ce.ID, "error", err)
+		return nil, errors.Wrap(err, "failed to read cost estimate log")
+	}
+	logBytes, err := io.ReadAll(logReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read cost estimate log")
+	}
+	if result.Resources, err = costestimate.ParseResources(logBytes); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FetchCostReport finds the cost estimate of the latest applied run of each workspace,
+// reading workspaces concurrently. The proposed monthly cost of that estimate is the current
+// cost of the workspace.
+func FetchCostReport(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, parallelism int) ([]view.WorkspaceCost, error) {
+	output.Get().Logger().Debug("Building cost report", "organization", orgName, "count", len(workspaces))
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		cost view.WorkspaceCost
+		err  error
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		cost := view.WorkspaceCost{WorkspaceName: w.Name, WorkspaceID: w.ID}
+		if w.Project != nil {
+			cost.ProjectName = projectNames[w.Project.ID]
+		}
+		err := fetchLatestAppliedCost(c, w, &cost)
+		return result{cost: cost, err: err}
+	})
+
+	costs := make([]view.WorkspaceCost, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		costs = append(costs, r.cost)
+	}
+	return costs, nil
+}
+
+// fetchLatestAppliedCost fills in cost from the latest applied run of the workspace with a
+// finished cost estimate, leaving it unestimated when there is none
+func fetchLatestAppliedCost(c *client.TfxClient, w *tfe.Workspace, cost *view.WorkspaceCost) error {
+	runs, err := c.Client.Runs.List(c.Context, w.ID, &tfe.RunListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: costReportRunLookback},
+		Status:      string(tfe.RunApplied),
+		Include:     []tfe.RunIncludeOpt{tfe.RunCostEstimate},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list runs for %s", w.Name)
+	}
+
+	for _, r := range runs.Items {
+		if r.CostEstimate == nil {
+			continue
+		}
+		ce := r.CostEstimate
+		if ce.Status == "" {
+			if ce, err = c.Client.CostEstimates.Read(c.Context, ce.ID); err != nil {
+				return errors.Wrapf(err, "failed to read cost estimate for %s", w.Name)
+			}
+		}
+		if ce.Status != tfe.CostEstimateFinished {
+			continue
+		}
+
+		monthly, err := costestimate.ParseAmount(ce.ProposedMonthlyCost)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read cost estimate for %s", w.Name)
+		}
+		cost.Estimated = true
+		cost.RunID = r.ID
+		cost.CostEstimateID = ce.ID
+		cost.MonthlyCost = monthly
+		if r.StatusTimestamps != nil && !r.StatusTimestamps.AppliedAt.IsZero() {
+			appliedAt := r.StatusTimestamps.AppliedAt
+			cost.AppliedAt = &appliedAt
+		}
+		return nil
+	}
+	return nil
+}
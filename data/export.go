@@ -0,0 +1,531 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"fmt"
+	"sort"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/hclexport"
+)
+
+// Resource kinds that can be exported
+const (
+	ExportProjects        = "projects"
+	ExportWorkspaces      = "workspaces"
+	ExportVariableSets    = "variable-sets"
+	ExportTeams           = "teams"
+	ExportRegistryModules = "registry-modules"
+)
+
+// ExportKinds lists every resource kind that can be exported
+var ExportKinds = []string{ExportProjects, ExportWorkspaces, ExportVariableSets, ExportTeams, ExportRegistryModules}
+
+// ExportOptions selects what is read for an export
+type ExportOptions struct {
+	ProjectName string
+	Include     []string
+	Parallelism int
+}
+
+func (o ExportOptions) includes(kind string) bool {
+	if len(o.Include) == 0 {
+		return true
+	}
+	for _, k := range o.Include {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportWorkspace is a workspace together with its variables and team access
+type ExportWorkspace struct {
+	Workspace  *tfe.Workspace
+	Variables  []*tfe.Variable
+	TeamAccess []*tfe.TeamAccess
+}
+
+// ExportResources holds everything read from the API for an export
+type ExportResources struct {
+	Hostname        string
+	Organization    string
+	Projects        []*tfe.Project
+	Workspaces      []*ExportWorkspace
+	VariableSets    []*tfe.VariableSet
+	Teams           []*tfe.Team
+	RegistryModules []*tfe.RegistryModule
+}
+
+// FetchExportResources reads the resources selected by opts for an organization
+func FetchExportResources(c *client.TfxClient, orgName string, opts ExportOptions) (*ExportResources, error) {
+	output.Get().Logger().Debug("Fetching export resources", "organization", orgName, "options", opts)
+
+	res := &ExportResources{Hostname: c.Hostname, Organization: orgName}
+
+	// Projects are always read, workspaces are grouped into files by project
+	projects, err := FetchProjects(c, orgName, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list projects")
+	}
+	var projectID string
+	for _, p := range projects {
+		if opts.ProjectName != "" && p.Name != opts.ProjectName {
+			continue
+		}
+		projectID = p.ID
+		res.Projects = append(res.Projects, p)
+	}
+	if opts.ProjectName != "" && projectID == "" {
+		return nil, fmt.Errorf("project %q not found", opts.ProjectName)
+	}
+
+	if opts.includes(ExportWorkspaces) {
+		workspaces, err := FetchWorkspaces(c, orgName, &flags.WorkspaceListFlags{ProjectID: projectID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list workspaces")
+		}
+
+		type result struct {
+			ws  *ExportWorkspace
+			err error
+		}
+		results := forEachConcurrent(workspaces, opts.Parallelism, func(w *tfe.Workspace) result {
+			variables, err := FetchVariables(c, w.ID)
+			if err != nil {
+				return result{err: errors.Wrapf(err, "failed to list variables for workspace %s", w.Name)}
+			}
+			access, err := FetchWorkspaceTeamAccess(c, w.ID, 0)
+			if err != nil {
+				return result{err: errors.Wrapf(err, "failed to list team access for workspace %s", w.Name)}
+			}
+			return result{ws: &ExportWorkspace{Workspace: w, Variables: variables, TeamAccess: access}}
+		})
+		for _, r := range results {
+			if r.err != nil {
+				return nil, r.err
+			}
+			res.Workspaces = append(res.Workspaces, r.ws)
+		}
+	}
+
+	if opts.includes(ExportVariableSets) {
+		sets, err := ListVariableSets(c, orgName, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list variable sets")
+		}
+		for _, vs := range sets {
+			full, err := ReadVariableSet(c, vs.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read variable set %s", vs.Name)
+			}
+			res.VariableSets = append(res.VariableSets, full)
+		}
+	}
+
+	if opts.includes(ExportTeams) {
+		res.Teams, err = FetchTeams(c, orgName)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list teams")
+		}
+	}
+
+	if opts.includes(ExportRegistryModules) {
+		res.RegistryModules, err = ListRegistryModules(c, orgName, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list registry modules")
+		}
+	}
+
+	output.Get().Logger().Debug("Export resources fetched", "organization", orgName,
+		"projects", len(res.Projects), "workspaces", len(res.Workspaces), "variableSets", len(res.VariableSets),
+		"teams", len(res.Teams), "registryModules", len(res.RegistryModules))
+	return res, nil
+}
+
+// hclExportBuilder converts ExportResources into Terraform configuration files
+type hclExportBuilder struct {
+	res   *ExportResources
+	opts  ExportOptions
+	names *hclexport.Names
+
+	// address lookups by ID, used to reference resources instead of hard coding IDs
+	projects   map[string]string
+	workspaces map[string]string
+	teams      map[string]string
+
+	variables *hclexport.File
+}
+
+// BuildHCLExport converts the exported resources into tfe provider configuration.
+// Projects and their workspaces get one file each, organization level resources
+// are written to their own files and sensitive values are replaced by input variables.
+func BuildHCLExport(res *ExportResources, opts ExportOptions) []*hclexport.File {
+	b := &hclExportBuilder{
+		res:        res,
+		opts:       opts,
+		names:      hclexport.NewNames(),
+		projects:   map[string]string{},
+		workspaces: map[string]string{},
+		teams:      map[string]string{},
+		variables:  hclexport.NewFile("variables.tf"),
+	}
+
+	files := []*hclexport.File{b.providers()}
+
+	// Teams first so team access can reference them
+	var teams *hclexport.File
+	if opts.includes(ExportTeams) {
+		teams = b.teamsFile()
+	}
+
+	files = append(files, b.projectFiles()...)
+	if teams != nil {
+		files = append(files, teams)
+	}
+	if opts.includes(ExportVariableSets) {
+		files = append(files, b.variableSetsFile())
+	}
+	if opts.includes(ExportRegistryModules) {
+		files = append(files, b.registryModulesFile())
+	}
+	if len(b.variables.Blocks()) > 0 {
+		files = append(files, b.variables)
+	}
+	return files
+}
+
+func (b *hclExportBuilder) providers() *hclexport.File {
+	f := hclexport.NewFile("providers.tf")
+
+	terraform := hclexport.NewBlock("terraform")
+	terraform.AddBlock("required_providers").
+		Set("tfe", map[string]interface{}{"source": "hashicorp/tfe"})
+
+	provider := hclexport.NewBlock("provider", "tfe").
+		Set("hostname", b.res.Hostname).
+		Set("organization", b.res.Organization)
+
+	f.Add(terraform, provider)
+	return f
+}
+
+// resource creates a resource block and its matching import block
+func (b *hclExportBuilder) resource(resourceType, name, importID string) (*hclexport.Block, *hclexport.Block) {
+	resource := hclexport.NewBlock("resource", resourceType, name)
+	imp := hclexport.NewBlock("import").
+		Set("to", hclexport.Expr(resourceType+"."+name)).
+		Set("id", importID)
+	return resource, imp
+}
+
+// ref returns a reference to the address for id, or the literal id when the
+// resource is not part of the export.
+func ref(addresses map[string]string, id string) interface{} {
+	if address, ok := addresses[id]; ok {
+		return hclexport.Expr(address + ".id")
+	}
+	return id
+}
+
+func (b *hclExportBuilder) projectFiles() []*hclexport.File {
+	byProject := map[string][]*ExportWorkspace{}
+	for _, w := range b.res.Workspaces {
+		if w.Workspace.Project != nil {
+			byProject[w.Workspace.Project.ID] = append(byProject[w.Workspace.Project.ID], w)
+		}
+	}
+
+	projects := append([]*tfe.Project(nil), b.res.Projects...)
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+
+	var files []*hclexport.File
+	for _, p := range projects {
+		name := b.names.Unique("tfe_project", p.Name)
+		f := hclexport.NewFile("project_" + name + ".tf")
+
+		if b.opts.includes(ExportProjects) {
+			resource, imp := b.resource("tfe_project", name, p.ID)
+			resource.Set("name", p.Name).
+				SetIf(p.Description != "", "description", p.Description)
+			f.Add(resource, imp)
+			b.projects[p.ID] = "tfe_project." + name
+		}
+
+		workspaces := byProject[p.ID]
+		sort.Slice(workspaces, func(i, j int) bool { return workspaces[i].Workspace.Name < workspaces[j].Workspace.Name })
+		for _, w := range workspaces {
+			f.Add(b.workspaceBlocks(w)...)
+		}
+
+		if len(f.Blocks()) > 0 {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (b *hclExportBuilder) workspaceBlocks(ew *ExportWorkspace) []*hclexport.Block {
+	w := ew.Workspace
+	name := b.names.Unique("tfe_workspace", w.Name)
+	address := "tfe_workspace." + name
+	b.workspaces[w.ID] = address
+
+	resource, imp := b.resource("tfe_workspace", name, w.ID)
+	resource.Set("name", w.Name)
+	if w.Project != nil {
+		resource.Set("project_id", ref(b.projects, w.Project.ID))
+	}
+	resource.SetIf(w.Description != "", "description", w.Description).
+		Set("auto_apply", w.AutoApply).
+		SetIf(w.TerraformVersion != "", "terraform_version", w.TerraformVersion).
+		SetIf(w.WorkingDirectory != "", "working_directory", w.WorkingDirectory).
+		SetIf(len(w.TagNames) > 0, "tag_names", w.TagNames)
+	if w.VCSRepo != nil {
+		vcs := resource.AddBlock("vcs_repo").
+			Set("identifier", w.VCSRepo.Identifier).
+			SetIf(w.VCSRepo.Branch != "", "branch", w.VCSRepo.Branch).
+			SetIf(w.VCSRepo.OAuthTokenID != "", "oauth_token_id", w.VCSRepo.OAuthTokenID).
+			SetIf(w.VCSRepo.GHAInstallationID != "", "github_app_installation_id", w.VCSRepo.GHAInstallationID)
+		vcs.SetIf(w.VCSRepo.IngressSubmodules, "ingress_submodules", true)
+	}
+	blocks := []*hclexport.Block{resource, imp}
+
+	// Execution mode lives on tfe_workspace_settings, only emit it when it differs from the default
+	if w.ExecutionMode != "" && w.ExecutionMode != "remote" {
+		settings, settingsImport := b.resource("tfe_workspace_settings", name, w.ID)
+		settings.Set("workspace_id", hclexport.Expr(address+".id")).
+			Set("execution_mode", w.ExecutionMode)
+		if w.AgentPool != nil && w.AgentPool.ID != "" {
+			settings.Set("agent_pool_id", w.AgentPool.ID)
+		}
+		blocks = append(blocks, settings, settingsImport)
+	}
+
+	variables := append([]*tfe.Variable(nil), ew.Variables...)
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Key < variables[j].Key })
+	for _, v := range variables {
+		varName := b.names.Unique("tfe_variable", name+"_"+v.Key)
+		importID := fmt.Sprintf("%s/%s/%s", b.res.Organization, w.Name, v.ID)
+		resource, imp := b.resource("tfe_variable", varName, importID)
+		b.variableAttributes(resource, v.Key, v.Value, v.Description, string(v.Category), v.HCL, v.Sensitive, varName)
+		resource.Set("workspace_id", hclexport.Expr(address+".id"))
+		blocks = append(blocks, resource, imp)
+	}
+
+	for _, ta := range ew.TeamAccess {
+		if ta.Team == nil {
+			continue
+		}
+		accessName := b.names.Unique("tfe_team_access", name+"_"+b.teamLabel(ta.Team.ID))
+		importID := fmt.Sprintf("%s/%s/%s", b.res.Organization, w.Name, ta.ID)
+		resource, imp := b.resource("tfe_team_access", accessName, importID)
+		resource.Set("team_id", ref(b.teams, ta.Team.ID)).
+			Set("workspace_id", hclexport.Expr(address+".id"))
+		if ta.Access == tfe.AccessCustom {
+			resource.AddBlock("permissions").
+				Set("runs", string(ta.Runs)).
+				Set("variables", string(ta.Variables)).
+				Set("state_versions", string(ta.StateVersions)).
+				Set("sentinel_mocks", string(ta.SentinelMocks)).
+				Set("workspace_locking", ta.WorkspaceLocking).
+				Set("run_tasks", ta.RunTasks)
+		} else {
+			resource.Set("access", string(ta.Access))
+		}
+		blocks = append(blocks, resource, imp)
+	}
+
+	return blocks
+}
+
+// teamLabel returns the team's name when known, otherwise its ID
+func (b *hclExportBuilder) teamLabel(teamID string) string {
+	for _, t := range b.res.Teams {
+		if t.ID == teamID {
+			return t.Name
+		}
+	}
+	return teamID
+}
+
+// variableAttributes sets the common tfe_variable attributes. Sensitive values
+// cannot be read from the API so they are replaced by an input variable.
+func (b *hclExportBuilder) variableAttributes(resource *hclexport.Block, key, value, description, category string, isHCL, sensitive bool, name string) {
+	resource.Set("key", key)
+	if sensitive {
+		inputName := b.names.Unique("variable", name)
+		b.variables.Add(hclexport.NewBlock("variable", inputName).
+			Set("type", hclexport.Expr("string")).
+			Set("description", fmt.Sprintf("Sensitive value for %s", key)).
+			Set("sensitive", true))
+		resource.Set("value", hclexport.Expr("var."+inputName))
+	} else {
+		resource.Set("value", value)
+	}
+	resource.Set("category", category).
+		SetIf(isHCL, "hcl", true).
+		SetIf(sensitive, "sensitive", true).
+		SetIf(description != "", "description", description)
+}
+
+func (b *hclExportBuilder) teamsFile() *hclexport.File {
+	f := hclexport.NewFile("teams.tf")
+
+	teams := append([]*tfe.Team(nil), b.res.Teams...)
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	for _, t := range teams {
+		name := b.names.Unique("tfe_team", t.Name)
+		b.teams[t.ID] = "tfe_team." + name
+
+		resource, imp := b.resource("tfe_team", name, fmt.Sprintf("%s/%s", b.res.Organization, t.ID))
+		resource.Set("name", t.Name).
+			SetIf(t.Visibility != "", "visibility", t.Visibility).
+			SetIf(t.SSOTeamID != "", "sso_team_id", t.SSOTeamID)
+		if a := t.OrganizationAccess; a != nil {
+			access := hclexport.NewBlock("organization_access").
+				SetIf(a.ManagePolicies, "manage_policies", true).
+				SetIf(a.ManagePolicyOverrides, "manage_policy_overrides", true).
+				SetIf(a.ManageWorkspaces, "manage_workspaces", true).
+				SetIf(a.ManageVCSSettings, "manage_vcs_settings", true).
+				SetIf(a.ManageProviders, "manage_providers", true).
+				SetIf(a.ManageModules, "manage_modules", true).
+				SetIf(a.ManageRunTasks, "manage_run_tasks", true).
+				SetIf(a.ManageProjects, "manage_projects", true).
+				SetIf(a.ReadWorkspaces, "read_workspaces", true).
+				SetIf(a.ReadProjects, "read_projects", true).
+				SetIf(a.ManageMembership, "manage_membership", true).
+				SetIf(a.ManageTeams, "manage_teams", true).
+				SetIf(a.ManageOrganizationAccess, "manage_organization_access", true).
+				SetIf(a.AccessSecretTeams, "access_secret_teams", true).
+				SetIf(a.ManageAgentPools, "manage_agent_pools", true)
+			if access.HasAttributes() {
+				resource.Append(access)
+			}
+		}
+		f.Add(resource, imp)
+	}
+	return f
+}
+
+func (b *hclExportBuilder) variableSetsFile() *hclexport.File {
+	f := hclexport.NewFile("variable_sets.tf")
+
+	sets := append([]*tfe.VariableSet(nil), b.res.VariableSets...)
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Name < sets[j].Name })
+	for _, vs := range sets {
+		name := b.names.Unique("tfe_variable_set", vs.Name)
+		address := "tfe_variable_set." + name
+
+		resource, imp := b.resource("tfe_variable_set", name, vs.ID)
+		resource.Set("name", vs.Name).
+			SetIf(vs.Description != "", "description", vs.Description).
+			SetIf(vs.Global, "global", true).
+			SetIf(vs.Priority, "priority", true)
+		if vs.Parent != nil && vs.Parent.Project != nil {
+			resource.Set("parent_project_id", ref(b.projects, vs.Parent.Project.ID))
+		}
+		f.Add(resource, imp)
+
+		variables := append([]*tfe.VariableSetVariable(nil), vs.Variables...)
+		sort.Slice(variables, func(i, j int) bool { return variables[i].Key < variables[j].Key })
+		for _, v := range variables {
+			varName := b.names.Unique("tfe_variable", name+"_"+v.Key)
+			importID := fmt.Sprintf("%s/%s/%s", b.res.Organization, vs.ID, v.ID)
+			resource, imp := b.resource("tfe_variable", varName, importID)
+			b.variableAttributes(resource, v.Key, v.Value, v.Description, string(v.Category), v.HCL, v.Sensitive, varName)
+			resource.Set("variable_set_id", hclexport.Expr(address+".id"))
+			f.Add(resource, imp)
+		}
+
+		if vs.Global {
+			continue
+		}
+		for _, w := range vs.Workspaces {
+			wsName := b.workspaceName(w)
+			assignName := b.names.Unique("tfe_workspace_variable_set", name+"_"+wsName)
+			importID := fmt.Sprintf("%s/%s/%s", b.res.Organization, wsName, vs.Name)
+			resource, imp := b.resource("tfe_workspace_variable_set", assignName, importID)
+			resource.Set("variable_set_id", hclexport.Expr(address+".id")).
+				Set("workspace_id", ref(b.workspaces, w.ID))
+			f.Add(resource, imp)
+		}
+		for _, p := range vs.Projects {
+			projectName := b.projectName(p)
+			assignName := b.names.Unique("tfe_project_variable_set", name+"_"+projectName)
+			importID := fmt.Sprintf("%s/%s/%s", b.res.Organization, projectName, vs.Name)
+			resource, imp := b.resource("tfe_project_variable_set", assignName, importID)
+			resource.Set("variable_set_id", hclexport.Expr(address+".id")).
+				Set("project_id", ref(b.projects, p.ID))
+			f.Add(resource, imp)
+		}
+	}
+	return f
+}
+
+// workspaceName returns the name of a related workspace, which is only an ID
+// on variable set relationships unless the workspace was exported.
+func (b *hclExportBuilder) workspaceName(w *tfe.Workspace) string {
+	if w.Name != "" {
+		return w.Name
+	}
+	for _, ew := range b.res.Workspaces {
+		if ew.Workspace.ID == w.ID {
+			return ew.Workspace.Name
+		}
+	}
+	return w.ID
+}
+
+// projectName returns the name of a related project, see workspaceName
+func (b *hclExportBuilder) projectName(p *tfe.Project) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	for _, rp := range b.res.Projects {
+		if rp.ID == p.ID {
+			return rp.Name
+		}
+	}
+	return p.ID
+}
+
+func (b *hclExportBuilder) registryModulesFile() *hclexport.File {
+	f := hclexport.NewFile("registry_modules.tf")
+
+	modules := append([]*tfe.RegistryModule(nil), b.res.RegistryModules...)
+	sort.Slice(modules, func(i, j int) bool {
+		if modules[i].Name != modules[j].Name {
+			return modules[i].Name < modules[j].Name
+		}
+		return modules[i].Provider < modules[j].Provider
+	})
+	for _, m := range modules {
+		name := b.names.Unique("tfe_registry_module", m.Name+"_"+m.Provider)
+		importID := fmt.Sprintf("%s/%s/%s/%s/%s/%s", b.res.Organization, m.RegistryName, m.Namespace, m.Name, m.Provider, m.ID)
+		resource, imp := b.resource("tfe_registry_module", name, importID)
+
+		if m.VCSRepo != nil {
+			resource.AddBlock("vcs_repo").
+				Set("display_identifier", m.VCSRepo.DisplayIdentifier).
+				Set("identifier", m.VCSRepo.Identifier).
+				SetIf(m.VCSRepo.OAuthTokenID != "", "oauth_token_id", m.VCSRepo.OAuthTokenID).
+				SetIf(m.VCSRepo.GHAInstallationID != "", "github_app_installation_id", m.VCSRepo.GHAInstallationID).
+				SetIf(m.VCSRepo.Branch != "", "branch", m.VCSRepo.Branch)
+		} else {
+			resource.Set("name", m.Name).
+				Set("module_provider", m.Provider).
+				Set("registry_name", string(m.RegistryName)).
+				SetIf(m.RegistryName == tfe.PublicRegistry, "namespace", m.Namespace)
+		}
+		f.Add(resource, imp)
+	}
+	return f
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// exportImportIDs parses the import blocks of the generated files into a map of
+// resource address to import ID
+func exportImportIDs(t *testing.T, res *ExportResources, opts ExportOptions) map[string]string {
+	t.Helper()
+
+	ids := map[string]string{}
+	for _, f := range BuildHCLExport(res, opts) {
+		file, diags := hclwrite.ParseConfig(f.Bytes(), f.Name, hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("%s is not valid HCL: %s", f.Name, diags)
+		}
+		for _, block := range file.Body().Blocks() {
+			if block.Type() != "import" {
+				continue
+			}
+			to := strings.TrimSpace(string(block.Body().GetAttribute("to").Expr().BuildTokens(nil).Bytes()))
+			id := strings.TrimSpace(string(block.Body().GetAttribute("id").Expr().BuildTokens(nil).Bytes()))
+			ids[to] = strings.Trim(id, `"`)
+		}
+	}
+	return ids
+}
+
+func TestBuildHCLExport_ImportIDs(t *testing.T) {
+	project := &tfe.Project{ID: "prj-1", Name: "Platform"}
+	team := &tfe.Team{ID: "team-1", Name: "owners"}
+	res := &ExportResources{
+		Hostname:     "app.terraform.io",
+		Organization: "firefly",
+		Projects:     []*tfe.Project{project},
+		Teams:        []*tfe.Team{team},
+		Workspaces: []*ExportWorkspace{
+			{
+				Workspace: &tfe.Workspace{ID: "ws-1", Name: "app-dev", Project: &tfe.Project{ID: "prj-1"}},
+				Variables: []*tfe.Variable{
+					{ID: "var-1", Key: "region", Value: "us-east-1", Category: tfe.CategoryTerraform},
+				},
+				TeamAccess: []*tfe.TeamAccess{
+					{ID: "tws-1", Access: tfe.AccessWrite, Team: &tfe.Team{ID: "team-1"}},
+				},
+			},
+		},
+	}
+	ids := exportImportIDs(t, res, ExportOptions{})
+
+	tests := []struct {
+		name    string
+		address string
+		want    string
+	}{
+		{name: "project", address: "tfe_project.platform", want: "prj-1"},
+		{name: "workspace", address: "tfe_workspace.app_dev", want: "ws-1"},
+		{name: "variable", address: "tfe_variable.app_dev_region", want: "firefly/app-dev/var-1"},
+		{name: "team access", address: "tfe_team_access.app_dev_owners", want: "firefly/app-dev/tws-1"},
+		{name: "team", address: "tfe_team.owners", want: "firefly/team-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ids[tt.address]
+			if !ok {
+				t.Fatalf("no import block for %s, got %v", tt.address, ids)
+			}
+			if got != tt.want {
+				t.Errorf("import ID for %s = %q, want %q", tt.address, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/depgraph"
+)
+
+// FetchDependencyGraph builds a graph of the run triggers and remote state consumers of every
+// workspace, reading workspaces concurrently. Workspaces outside the given set that trigger or
+// consume one of them are included as nodes.
+func FetchDependencyGraph(c *client.TfxClient, workspaces []*tfe.Workspace, parallelism int) (*depgraph.Graph, error) {
+	output.Get().Logger().Debug("Building dependency graph", "count", len(workspaces), "parallelism", parallelism)
+
+	type result struct {
+		triggers  []*tfe.RunTrigger
+		consumers []*tfe.Workspace
+		err       error
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		triggers, err := FetchRunTriggers(c, w.ID, tfe.RunTriggerInbound)
+		if err != nil {
+			return result{err: errors.Wrapf(err, "failed to list run triggers for %s", w.Name)}
+		}
+
+		// Consumers are ignored by the API while global sharing is on, so they are not edges
+		if w.GlobalRemoteState {
+			return result{triggers: triggers}
+		}
+		consumers, err := FetchWorkspaceRemoteStateConsumers(c, w.ID)
+		if err != nil {
+			return result{err: errors.Wrapf(err, "failed to list remote state consumers for %s", w.Name)}
+		}
+		return result{triggers: triggers, consumers: consumers}
+	})
+
+	g := depgraph.New()
+	for _, w := range workspaces {
+		g.AddNode(depgraph.Node{ID: w.ID, Name: w.Name, GlobalRemoteState: w.GlobalRemoteState})
+	}
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		w := workspaces[i]
+		for _, rt := range r.triggers {
+			sourceID := runTriggerSourceID(rt)
+			if sourceID == "" {
+				continue
+			}
+			g.AddNode(depgraph.Node{ID: sourceID, Name: rt.SourceableName})
+			g.AddEdge(depgraph.Edge{From: sourceID, To: w.ID, Kind: depgraph.EdgeRunTrigger})
+		}
+		for _, consumer := range r.consumers {
+			g.AddNode(depgraph.Node{ID: consumer.ID, Name: consumer.Name})
+			g.AddEdge(depgraph.Edge{From: w.ID, To: consumer.ID, Kind: depgraph.EdgeRemoteState})
+		}
+	}
+
+	output.Get().Logger().Debug("Dependency graph built", "nodes", len(g.Nodes), "edges", len(g.Edges))
+	return g.Sorted(), nil
+}
+
+func runTriggerSourceID(rt *tfe.RunTrigger) string {
+	if rt.SourceableChoice != nil && rt.SourceableChoice.Workspace != nil {
+		return rt.SourceableChoice.Workspace.ID
+	}
+	if rt.Sourceable != nil {
+		return rt.Sourceable.ID
+	}
+	return ""
+}
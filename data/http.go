@@ -4,9 +4,13 @@
 package data
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+
+	"github.com/straubt1/tfx/client"
 )
 
 // UploadBinary performs a PUT of the file at path to the given pre-signed URL
@@ -50,3 +54,36 @@ func DownloadTextFile(downloadURL string) (string, error) {
 	}
 	return string(b), nil
 }
+
+// fetchAPI performs an authenticated GET against an API path that go-tfe does not cover,
+// such as /api/v2/assessment-results/:id, and returns the response body
+func fetchAPI(c *client.TfxClient, path string) ([]byte, error) {
+	apiURL := fmt.Sprintf("https://%s%s", c.Hostname, path)
+
+	req, err := http.NewRequestWithContext(c.Context, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching %s", resp.StatusCode, path)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// fetchAPIJSON performs fetchAPI and decodes the JSON response into v
+func fetchAPIJSON(c *client.TfxClient, path string, v interface{}) error {
+	body, err := fetchAPI(c, path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// NotificationTemplate is the on-disk format of a notification configuration applied to many workspaces.
+// Workspaces are matched on Name, an existing configuration with the same name is updated.
+type NotificationTemplate struct {
+	Name            string   `json:"name"`
+	DestinationType string   `json:"destinationType"`
+	URL             string   `json:"url,omitempty"`
+	Token           string   `json:"token,omitempty"`
+	Triggers        []string `json:"triggers"`
+	EmailAddresses  []string `json:"emailAddresses,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+}
+
+func (t NotificationTemplate) enabled() bool {
+	return t.Enabled == nil || *t.Enabled
+}
+
+// CreateOptions returns the options to create the template's notification configuration
+func (t NotificationTemplate) CreateOptions() tfe.NotificationConfigurationCreateOptions {
+	opts := tfe.NotificationConfigurationCreateOptions{
+		Name:            tfe.String(t.Name),
+		DestinationType: tfe.NotificationDestination(tfe.NotificationDestinationType(t.DestinationType)),
+		Enabled:         tfe.Bool(t.enabled()),
+		Triggers:        notificationTriggerTypes(t.Triggers),
+		EmailAddresses:  t.EmailAddresses,
+	}
+	if t.URL != "" {
+		opts.URL = tfe.String(t.URL)
+	}
+	if t.Token != "" {
+		opts.Token = tfe.String(t.Token)
+	}
+	return opts
+}
+
+// UpdateOptions returns the options to bring an existing notification configuration in line with the template
+func (t NotificationTemplate) UpdateOptions() tfe.NotificationConfigurationUpdateOptions {
+	opts := tfe.NotificationConfigurationUpdateOptions{
+		Name:           tfe.String(t.Name),
+		Enabled:        tfe.Bool(t.enabled()),
+		Triggers:       notificationTriggerTypes(t.Triggers),
+		EmailAddresses: t.EmailAddresses,
+	}
+	if t.URL != "" {
+		opts.URL = tfe.String(t.URL)
+	}
+	if t.Token != "" {
+		opts.Token = tfe.String(t.Token)
+	}
+	return opts
+}
+
+// ReadNotificationTemplate reads and validates a notification template file
+func ReadNotificationTemplate(path string) (*NotificationTemplate, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var t NotificationTemplate
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, errors.Wrap(err, "failed to parse notification template")
+	}
+	if t.Name == "" {
+		return nil, errors.New("notification template requires a name")
+	}
+	if err := flags.ValidateNotificationDestination(t.DestinationType, t.URL); err != nil {
+		return nil, err
+	}
+	if err := flags.ValidateNotificationTriggers(t.Triggers); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// FetchNotificationConfigurations fetches all notification configurations for a workspace
+func FetchNotificationConfigurations(c *client.TfxClient, workspaceID string) ([]*tfe.NotificationConfiguration, error) {
+	output.Get().Logger().Debug("Fetching notification configurations", "workspaceID", workspaceID)
+
+	return client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.NotificationConfiguration, *client.Pagination, error) {
+		output.Get().Logger().Trace("Fetching notification configurations page", "workspaceID", workspaceID, "page", pageNumber)
+
+		opts := &tfe.NotificationConfigurationListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		}
+
+		result, err := c.Client.NotificationConfigurations.List(c.Context, workspaceID, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to fetch notification configurations page", "workspaceID", workspaceID, "page", pageNumber, "error", err)
+			return nil, nil, err
+		}
+
+		output.Get().Logger().Trace("Notification configurations page fetched", "workspaceID", workspaceID, "page", pageNumber, "count", len(result.Items))
+		return result.Items, client.NewPaginationFromTFE(result.Pagination), nil
+	})
+}
+
+// FetchNotificationConfiguration fetches a single notification configuration on a workspace by ID,
+// or by name when no ID is given. Names are not unique, so a name matching more than one
+// configuration is an error.
+func FetchNotificationConfiguration(c *client.TfxClient, workspaceID string, name string, id string) (*tfe.NotificationConfiguration, error) {
+	if id != "" {
+		output.Get().Logger().Debug("Reading notification configuration", "notificationID", id)
+		nc, err := c.Client.NotificationConfigurations.Read(c.Context, id)
+		if err != nil {
+			output.Get().Logger().Error("Failed to read notification configuration", "notificationID", id, "error", err)
+			return nil, err
+		}
+		return nc, nil
+	}
+
+	configs, err := FetchNotificationConfigurations(c, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *tfe.NotificationConfiguration
+	for _, nc := range configs {
+		if nc.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one notification configuration is named %q, use --id instead", name)
+		}
+		found = nc
+	}
+	if found == nil {
+		return nil, fmt.Errorf("notification configuration %q not found", name)
+	}
+	return found, nil
+}
+
+// CreateNotificationConfiguration creates a notification configuration on a workspace
+func CreateNotificationConfiguration(c *client.TfxClient, workspaceID string, opts tfe.NotificationConfigurationCreateOptions) (*tfe.NotificationConfiguration, error) {
+	output.Get().Logger().Debug("Creating notification configuration", "workspaceID", workspaceID, "name", *opts.Name)
+
+	nc, err := c.Client.NotificationConfigurations.Create(c.Context, workspaceID, opts)
+	if err != nil {
+		output.Get().Logger().Error("Failed to create notification configuration", "workspaceID", workspaceID, "name", *opts.Name, "error", err)
+		return nil, err
+	}
+
+	output.Get().Logger().Debug("Notification configuration created", "workspaceID", workspaceID, "notificationID", nc.ID)
+	return nc, nil
+}
+
+// UpdateNotificationConfiguration updates a notification configuration
+func UpdateNotificationConfiguration(c *client.TfxClient, notificationID string, opts tfe.NotificationConfigurationUpdateOptions) (*tfe.NotificationConfiguration, error) {
+	output.Get().Logger().Debug("Updating notification configuration", "notificationID", notificationID)
+
+	nc, err := c.Client.NotificationConfigurations.Update(c.Context, notificationID, opts)
+	if err != nil {
+		output.Get().Logger().Error("Failed to update notification configuration", "notificationID", notificationID, "error", err)
+		return nil, err
+	}
+	return nc, nil
+}
+
+// DeleteNotificationConfiguration deletes a notification configuration
+func DeleteNotificationConfiguration(c *client.TfxClient, notificationID string) error {
+	output.Get().Logger().Debug("Deleting notification configuration", "notificationID", notificationID)
+
+	if err := c.Client.NotificationConfigurations.Delete(c.Context, notificationID); err != nil {
+		output.Get().Logger().Error("Failed to delete notification configuration", "notificationID", notificationID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// VerifyNotificationConfiguration sends a test notification and returns the configuration with
+// the delivery responses
+func VerifyNotificationConfiguration(c *client.TfxClient, notificationID string) (*tfe.NotificationConfiguration, error) {
+	output.Get().Logger().Debug("Verifying notification configuration", "notificationID", notificationID)
+
+	nc, err := c.Client.NotificationConfigurations.Verify(c.Context, notificationID)
+	if err != nil {
+		output.Get().Logger().Error("Failed to verify notification configuration", "notificationID", notificationID, "error", err)
+		return nil, err
+	}
+	return nc, nil
+}
+
+// ApplyNotificationTemplate creates or updates the template's notification configuration on every
+// workspace concurrently. With dryRun set only the planned action is reported.
+func ApplyNotificationTemplate(c *client.TfxClient, workspaces []*tfe.Workspace, template *NotificationTemplate, dryRun bool, parallelism int) []view.NotificationApplyResult {
+	output.Get().Logger().Debug("Applying notification template", "name", template.Name, "count", len(workspaces), "dryRun", dryRun)
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.NotificationApplyResult {
+		result := view.NotificationApplyResult{WorkspaceName: w.Name, WorkspaceID: w.ID}
+
+		configs, err := FetchNotificationConfigurations(c, w.ID)
+		if err != nil {
+			result.Status, result.Error = view.NotificationFailed, err.Error()
+			return result
+		}
+
+		var existing *tfe.NotificationConfiguration
+		for _, nc := range configs {
+			if nc.Name == template.Name {
+				existing = nc
+				break
+			}
+		}
+
+		if existing != nil && existing.DestinationType != tfe.NotificationDestinationType(template.DestinationType) {
+			result.NotificationID = existing.ID
+			result.Status = fmt.Sprintf("Skipped: existing notification is a %s destination", existing.DestinationType)
+			return result
+		}
+
+		if dryRun {
+			result.Status = view.NotificationCreate
+			if existing != nil {
+				result.NotificationID = existing.ID
+				result.Status = view.NotificationUpdate
+			}
+			return result
+		}
+
+		if existing != nil {
+			nc, err := UpdateNotificationConfiguration(c, existing.ID, template.UpdateOptions())
+			result.NotificationID = existing.ID
+			if err != nil {
+				result.Status, result.Error = view.NotificationFailed, err.Error()
+				return result
+			}
+			result.NotificationID = nc.ID
+			result.Status = view.NotificationUpdated
+			return result
+		}
+
+		nc, err := CreateNotificationConfiguration(c, w.ID, template.CreateOptions())
+		if err != nil {
+			result.Status, result.Error = view.NotificationFailed, err.Error()
+			return result
+		}
+		result.NotificationID = nc.ID
+		result.Status = view.NotificationCreated
+		return result
+	})
+}
+
+func notificationTriggerTypes(triggers []string) []tfe.NotificationTriggerType {
+	types := make([]tfe.NotificationTriggerType, len(triggers))
+	for i, t := range triggers {
+		types[i] = tfe.NotificationTriggerType(t)
+	}
+	return types
+}
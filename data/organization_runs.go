@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/cmd/flags"
+	"github.com/straubt1/tfx/output"
+)
+
+// orgRunWorkspaceBatch is the number of workspace names sent in a single organization run
+// listing, keeping the request URL to a reasonable length
+const orgRunWorkspaceBatch = 50
+
+// RunFilter narrows a run listing. Operations use hyphenated names such as plan-only. Since and
+// Until are compared with the time a run was created, which is also the order runs are listed in.
+type RunFilter struct {
+	Statuses    []string
+	Sources     []string
+	Operations  []string
+	TriggeredBy string
+	Since       time.Time
+	Until       time.Time
+}
+
+// Match returns true if the run passes the filters the API can not apply
+func (f RunFilter) Match(r *tfe.Run) bool {
+	if !f.Since.IsZero() && r.CreatedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && r.CreatedAt.After(f.Until) {
+		return false
+	}
+	if f.TriggeredBy != "" && (r.CreatedBy == nil || !strings.EqualFold(r.CreatedBy.Username, f.TriggeredBy)) {
+		return false
+	}
+	return true
+}
+
+// operationFilter returns the operations in the form the API expects
+func (f RunFilter) operationFilter() string {
+	ops := make([]string, len(f.Operations))
+	for i, o := range f.Operations {
+		ops[i] = strings.ReplaceAll(o, "-", "_")
+	}
+	return strings.Join(ops, ",")
+}
+
+// FetchOrganizationRuns lists the runs matching filter, newest first, limited to maxItems when
+// it is above zero. Runs are listed for the whole organization when workspaces is nil.
+// The organization run listing is used where available, otherwise the runs of each workspace
+// are listed concurrently.
+func FetchOrganizationRuns(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, filter RunFilter, maxItems int, parallelism int) ([]*tfe.Run, error) {
+	output.Get().Logger().Debug("Fetching organization runs", "organization", orgName, "workspaces", len(workspaces), "filter", filter)
+
+	var names []string
+	for _, w := range workspaces {
+		names = append(names, w.Name)
+	}
+
+	var runs []*tfe.Run
+	var err error
+	if workspaces == nil {
+		runs, err = listOrganizationRuns(c, orgName, "", filter, maxItems)
+	} else {
+		for start := 0; start < len(names); start += orgRunWorkspaceBatch {
+			end := min(start+orgRunWorkspaceBatch, len(names))
+			var batch []*tfe.Run
+			batch, err = listOrganizationRuns(c, orgName, strings.Join(names[start:end], ","), filter, maxItems)
+			if err != nil {
+				break
+			}
+			runs = append(runs, batch...)
+		}
+	}
+
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		output.Get().Logger().Warn("Organization run listing is not available, listing runs per workspace", "organization", orgName)
+		if workspaces == nil {
+			workspaces, err = FetchWorkspaces(c, orgName, &flags.WorkspaceListFlags{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		runs, err = listWorkspaceRuns(c, workspaces, filter, maxItems, parallelism)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+	if maxItems > 0 && len(runs) > maxItems {
+		runs = runs[:maxItems]
+	}
+
+	output.Get().Logger().Debug("Organization runs fetched", "organization", orgName, "count", len(runs))
+	return runs, nil
+}
+
+// listOrganizationRuns pages through the organization run listing until maxItems runs match or
+// the runs are older than filter.Since
+func listOrganizationRuns(c *client.TfxClient, orgName string, workspaceNames string, filter RunFilter, maxItems int) ([]*tfe.Run, error) {
+	opts := &tfe.RunListForOrganizationOptions{
+		ListOptions:    tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Status:         strings.Join(filter.Statuses, ","),
+		Source:         strings.Join(filter.Sources, ","),
+		Operation:      filter.operationFilter(),
+		WorkspaceNames: workspaceNames,
+		Include:        []tfe.RunIncludeOpt{tfe.RunWorkspace, tfe.RunCreatedBy},
+	}
+
+	var runs []*tfe.Run
+	for {
+		output.Get().Logger().Trace("Fetching organization runs page", "organization", orgName, "page", opts.PageNumber)
+		res, err := c.Client.Runs.ListForOrganization(c.Context, orgName, opts)
+		if err != nil {
+			output.Get().Logger().Debug("Failed to fetch organization runs page", "organization", orgName, "page", opts.PageNumber, "error", err)
+			return nil, err
+		}
+
+		var done bool
+		runs, done = appendMatchingRuns(runs, res.Items, filter, maxItems)
+		if done || res.PaginationNextPrev == nil || res.NextPage <= res.CurrentPage {
+			return runs, nil
+		}
+		opts.PageNumber = res.NextPage
+	}
+}
+
+// listWorkspaceRuns lists the runs of each workspace concurrently
+func listWorkspaceRuns(c *client.TfxClient, workspaces []*tfe.Workspace, filter RunFilter, maxItems int, parallelism int) ([]*tfe.Run, error) {
+	type result struct {
+		runs []*tfe.Run
+		err  error
+	}
+
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		opts := &tfe.RunListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+			Status:      strings.Join(filter.Statuses, ","),
+			Source:      strings.Join(filter.Sources, ","),
+			Operation:   filter.operationFilter(),
+			Include:     []tfe.RunIncludeOpt{tfe.RunCreatedBy},
+		}
+
+		var runs []*tfe.Run
+		for {
+			output.Get().Logger().Trace("Fetching workspace runs page", "workspaceID", w.ID, "page", opts.PageNumber)
+			res, err := c.Client.Runs.List(c.Context, w.ID, opts)
+			if err != nil {
+				output.Get().Logger().Error("Failed to list runs", "workspaceID", w.ID, "page", opts.PageNumber, "error", err)
+				return result{err: errors.Wrapf(err, "failed to list runs for workspace %s", w.Name)}
+			}
+			for _, r := range res.Items {
+				if r.Workspace == nil || r.Workspace.Name == "" {
+					r.Workspace = w
+				}
+			}
+
+			var done bool
+			runs, done = appendMatchingRuns(runs, res.Items, filter, maxItems)
+			if done || res.Pagination == nil || res.CurrentPage >= res.TotalPages {
+				return result{runs: runs}
+			}
+			opts.PageNumber = res.NextPage
+		}
+	})
+
+	var runs []*tfe.Run
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		runs = append(runs, r.runs...)
+	}
+	return runs, nil
+}
+
+// appendMatchingRuns appends the runs of a page that match filter. Pages are ordered newest
+// first, so listing is done once maxItems runs match or a run is older than filter.Since.
+func appendMatchingRuns(runs []*tfe.Run, page []*tfe.Run, filter RunFilter, maxItems int) ([]*tfe.Run, bool) {
+	for _, r := range page {
+		if !filter.Since.IsZero() && r.CreatedAt.Before(filter.Since) {
+			return runs, true
+		}
+		if !filter.Match(r) {
+			continue
+		}
+		runs = append(runs, r)
+		if maxItems > 0 && len(runs) >= maxItems {
+			return runs, true
+		}
+	}
+	return runs, len(page) == 0
+}
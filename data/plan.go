@@ -4,11 +4,17 @@
 package data
 
 import (
+	"sort"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/pkg/errors"
 	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
 	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/planjson"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // FetchPlan retrieves a plan by ID
@@ -74,3 +80,150 @@ func FetchPlanJSONOutput(c *client.TfxClient, planID string) ([]byte, error) {
 	output.Get().Logger().Debug("Plan JSON output fetched successfully", "planID", planID, "size", len(jsonOutput))
 	return jsonOutput, nil
 }
+
+// FetchPlanChangeSummary summarizes the resource changes of a plan from its JSON output
+func FetchPlanChangeSummary(c *client.TfxClient, planID string) (planjson.Summary, error) {
+	parsed, err := fetchParsedPlan(c, planID)
+	if err != nil {
+		return planjson.Summary{}, err
+	}
+	return parsed.Summarize(), nil
+}
+
+// FetchPlanComparison compares the resource changes of otherPlanID against planID
+func FetchPlanComparison(c *client.TfxClient, planID string, otherPlanID string) (planjson.Comparison, error) {
+	base, err := fetchParsedPlan(c, planID)
+	if err != nil {
+		return planjson.Comparison{}, errors.Wrapf(err, "failed to read plan %s", planID)
+	}
+	other, err := fetchParsedPlan(c, otherPlanID)
+	if err != nil {
+		return planjson.Comparison{}, errors.Wrapf(err, "failed to read plan %s", otherPlanID)
+	}
+
+	comparison := planjson.Compare(base, other)
+	output.Get().Logger().Debug("Plans compared", "planID", planID, "otherPlanID", otherPlanID, "differences", comparison.Differences())
+	return comparison, nil
+}
+
+// fetchParsedPlan reads and parses the JSON output of a plan
+func fetchParsedPlan(c *client.TfxClient, planID string) (*planjson.Plan, error) {
+	jsonOutput, err := FetchPlanJSONOutput(c, planID)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := planjson.Parse(jsonOutput)
+	if err != nil {
+		output.Get().Logger().Error("Failed to parse plan JSON output", "planID", planID, "error", err)
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// FetchRunPlanSummary summarizes the plan of a run. The resources that change are read from
+// the plan's JSON output; when it cannot be read the summary only has the counts.
+func FetchRunPlanSummary(c *client.TfxClient, run *tfe.Run, workspaceName string) (view.RunPlanSummary, error) {
+	output.Get().Logger().Debug("Fetching run plan summary", "runID", run.ID)
+
+	summary := view.RunPlanSummary{
+		RunID:           run.ID,
+		WorkspaceName:   workspaceName,
+		Message:         run.Message,
+		Status:          string(run.Status),
+		ResourceChanges: []view.PlannedResourceChange{},
+	}
+	if run.Plan == nil {
+		return summary, nil
+	}
+
+	plan, err := FetchPlan(c, run.Plan.ID)
+	if err != nil {
+		return summary, err
+	}
+	summary.Additions = plan.ResourceAdditions
+	summary.Changes = plan.ResourceChanges
+	summary.Destructions = plan.ResourceDestructions
+
+	jsonOutput, err := FetchPlanJSONOutput(c, run.Plan.ID)
+	if err != nil {
+		output.Get().Logger().Warn("Plan JSON output unavailable, summarizing counts only", "planID", run.Plan.ID, "error", err)
+		return summary, nil
+	}
+	parsed, err := planjson.Parse(jsonOutput)
+	if err != nil {
+		output.Get().Logger().Warn("Failed to parse plan JSON output", "planID", run.Plan.ID, "error", err)
+		return summary, nil
+	}
+	for _, rc := range parsed.ResourceChanges {
+		action := rc.Change.Action()
+		if action == planjson.ActionNoOp || action == planjson.ActionRead {
+			continue
+		}
+		summary.ResourceChanges = append(summary.ResourceChanges, view.PlannedResourceChange{Address: rc.Address, Action: action})
+	}
+	return summary, nil
+}
+
+// PlanRunOptions holds the options for a run created to plan a workspace
+type PlanRunOptions struct {
+	Message     string
+	PlanOnly    bool
+	Destroy     bool
+	RefreshOnly bool
+	Targets     []string
+	Replaces    []string
+	// Variables are run-scoped Terraform variables that are always passed as strings
+	Variables map[string]string
+	// HCLVariables are run-scoped Terraform variables with values that are passed as HCL
+	// expressions
+	HCLVariables map[string]string
+}
+
+// CreatePlanRun creates a run for a workspace from a configuration version. The configuration
+// version may be nil to use the latest one.
+func CreatePlanRun(c *client.TfxClient, workspace *tfe.Workspace, cv *tfe.ConfigurationVersion, opts PlanRunOptions) (*tfe.Run, error) {
+	output.Get().Logger().Debug("Creating plan run", "workspaceID", workspace.ID, "planOnly", opts.PlanOnly, "destroy", opts.Destroy, "refreshOnly", opts.RefreshOnly)
+
+	createOpts := tfe.RunCreateOptions{
+		Workspace:            workspace,
+		ConfigurationVersion: cv,
+		IsDestroy:            tfe.Bool(opts.Destroy),
+		TargetAddrs:          opts.Targets,
+		ReplaceAddrs:         opts.Replaces,
+	}
+	if opts.Message != "" {
+		createOpts.Message = tfe.String(opts.Message)
+	}
+	if opts.PlanOnly {
+		createOpts.PlanOnly = tfe.Bool(true)
+	}
+	if opts.RefreshOnly {
+		createOpts.RefreshOnly = tfe.Bool(true)
+	}
+
+	variables := make(map[string]string, len(opts.Variables)+len(opts.HCLVariables))
+	// Run variable values are HCL expressions, plain values are written as string literals
+	for k, v := range opts.Variables {
+		variables[k] = string(hclwrite.TokensForValue(cty.StringVal(v)).Bytes())
+	}
+	for k, v := range opts.HCLVariables {
+		variables[k] = v
+	}
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		createOpts.Variables = append(createOpts.Variables, &tfe.RunVariable{Key: k, Value: variables[k]})
+	}
+
+	run, err := c.Client.Runs.Create(c.Context, createOpts)
+	if err != nil {
+		output.Get().Logger().Error("Failed to create plan run", "workspaceID", workspace.ID, "error", err)
+		return nil, err
+	}
+
+	output.Get().Logger().Debug("Plan run created", "runID", run.ID)
+	return run, nil
+}
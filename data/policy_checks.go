@@ -52,16 +52,130 @@ func FetchRunPolicyDetails(c *client.TfxClient, runID string, fetchLogs bool) (*
 	}
 	result.PolicyEvaluations = evaluations
 
+	// Run task results share the task stages with policy evaluations
+	result.TaskResults = view.NewTaskResultDetails(FetchRunTaskResults(c, runID))
+
 	result.HasPolicies = len(result.PolicyChecks) > 0 || len(result.PolicyEvaluations) > 0
 
 	log.Debug("Policy details fetched",
 		"runID", runID,
 		"policyChecks", len(result.PolicyChecks),
 		"policyEvaluations", len(result.PolicyEvaluations),
+		"taskResults", len(result.TaskResults),
 	)
 	return result, nil
 }
 
+// OverrideRunPolicies posts comment on a run as the justification, then overrides the soft
+// failed legacy policy checks and the task stages awaiting an override. Nothing is overridden
+// unless the caller can override every one of them. When an override or reading the run fails
+// after the comment is posted, the partial result is returned with the error.
+func OverrideRunPolicies(c *client.TfxClient, runID string, comment string) (*view.RunPolicyOverrideResult, error) {
+	log := output.Get().Logger()
+	log.Debug("Overriding policies for run", "runID", runID)
+
+	run, err := c.Client.Runs.ReadWithOptions(c.Context, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunTaskStages},
+	})
+	if err != nil {
+		log.Error("Failed to fetch run", "runID", runID, "error", err)
+		return nil, err
+	}
+
+	policyChecks, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.PolicyCheck, *client.Pagination, error) {
+		opts := &tfe.PolicyCheckListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		}
+		res, err := c.Client.PolicyChecks.List(c.Context, runID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		log.Error("Failed to list policy checks", "runID", runID, "error", err)
+		return nil, err
+	}
+
+	result := &view.RunPolicyOverrideResult{RunID: runID, Overridden: []view.PolicyOverrideTarget{}}
+
+	// Check every override first, so a run is never left partially overridden
+	var checks []*tfe.PolicyCheck
+	for _, pc := range policyChecks {
+		if pc.Status != tfe.PolicySoftFailed {
+			continue
+		}
+		if pc.Actions == nil || !pc.Actions.IsOverridable {
+			return nil, fmt.Errorf("policy check '%s' can not be overridden", pc.ID)
+		}
+		if pc.Permissions == nil || !pc.Permissions.CanOverride {
+			return nil, fmt.Errorf("you do not have permission to override policy check '%s'", pc.ID)
+		}
+		checks = append(checks, pc)
+	}
+	var stages []*tfe.TaskStage
+	for _, ts := range run.TaskStages {
+		if ts.Status != tfe.TaskStageAwaitingOverride {
+			continue
+		}
+		if ts.Actions == nil || !isTrue(ts.Actions.IsOverridable) {
+			return nil, fmt.Errorf("task stage '%s' can not be overridden", ts.ID)
+		}
+		if ts.Permissions == nil || !isTrue(ts.Permissions.CanOverridePolicy) {
+			return nil, fmt.Errorf("you do not have permission to override policies in task stage '%s'", ts.ID)
+		}
+		stages = append(stages, ts)
+	}
+	if len(checks) == 0 && len(stages) == 0 {
+		return nil, fmt.Errorf("run '%s' has no policy failures waiting for an override (status %s)", runID, run.Status)
+	}
+
+	runComment, err := CreateRunComment(c, runID, comment)
+	if err != nil {
+		return nil, err
+	}
+	result.CommentID = runComment.ID
+	result.Comment = runComment.Body
+
+	for _, pc := range checks {
+		log.Debug("Overriding policy check", "id", pc.ID)
+		if _, err := c.Client.PolicyChecks.Override(c.Context, pc.ID); err != nil {
+			log.Error("Failed to override policy check", "id", pc.ID, "error", err)
+			return result, err
+		}
+		result.Overridden = append(result.Overridden, view.PolicyOverrideTarget{
+			Kind:  view.PolicyOverridePolicyCheck,
+			ID:    pc.ID,
+			Scope: string(pc.Scope),
+		})
+	}
+	for _, ts := range stages {
+		log.Debug("Overriding task stage", "id", ts.ID)
+		if _, err := c.Client.TaskStages.Override(c.Context, ts.ID, tfe.TaskStageOverrideOptions{Comment: tfe.String(comment)}); err != nil {
+			log.Error("Failed to override task stage", "id", ts.ID, "error", err)
+			return result, err
+		}
+		result.Overridden = append(result.Overridden, view.PolicyOverrideTarget{
+			Kind:  view.PolicyOverrideTaskStage,
+			ID:    ts.ID,
+			Scope: string(ts.Stage),
+		})
+	}
+
+	run, err = c.Client.Runs.Read(c.Context, runID)
+	if err != nil {
+		log.Error("Failed to read run", "runID", runID, "error", err)
+		return result, err
+	}
+	result.RunStatus = string(run.Status)
+	return result, nil
+}
+
+// isTrue returns the value of an optional API flag, false when it is not set
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
 // fetchLegacyPolicyChecks lists and reads all legacy Sentinel policy checks for a run
 func fetchLegacyPolicyChecks(c *client.TfxClient, runID string, fetchLogs bool) ([]view.PolicyCheckDetail, error) {
 	log := output.Get().Logger()
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"sort"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// SetGlobalRemoteState enables or disables sharing a workspace's state with every workspace
+// in the organization
+func SetGlobalRemoteState(c *client.TfxClient, workspaceID string, enabled bool) (*tfe.Workspace, error) {
+	output.Get().Logger().Debug("Setting global remote state", "workspaceID", workspaceID, "enabled", enabled)
+
+	workspace, err := c.Client.Workspaces.UpdateByID(c.Context, workspaceID, tfe.WorkspaceUpdateOptions{
+		GlobalRemoteState: tfe.Bool(enabled),
+	})
+	if err != nil {
+		output.Get().Logger().Error("Failed to set global remote state", "workspaceID", workspaceID, "error", err)
+		return nil, err
+	}
+	return workspace, nil
+}
+
+// PlanRemoteStateConsumerAdd plans adding every selected workspace as a consumer.
+// Workspaces that are already consumers are skipped.
+func PlanRemoteStateConsumerAdd(workspace *tfe.Workspace, current []*tfe.Workspace, selected []*tfe.Workspace) []view.RemoteStateConsumerChange {
+	existing := workspaceIDs(current)
+
+	changes := make([]view.RemoteStateConsumerChange, 0, len(selected))
+	for _, w := range selected {
+		change := view.RemoteStateConsumerChange{ConsumerName: w.Name, ConsumerID: w.ID, Status: view.RemoteStateConsumerAdd}
+		switch {
+		case w.ID == workspace.ID:
+			change.Status = view.RemoteStateConsumerSelf
+		case existing[w.ID]:
+			change.Status = view.RemoteStateConsumerExists
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// PlanRemoteStateConsumerRemove plans removing every selected workspace as a consumer.
+// Workspaces that are not consumers are skipped.
+func PlanRemoteStateConsumerRemove(workspace *tfe.Workspace, current []*tfe.Workspace, selected []*tfe.Workspace) []view.RemoteStateConsumerChange {
+	existing := workspaceIDs(current)
+
+	changes := make([]view.RemoteStateConsumerChange, 0, len(selected))
+	for _, w := range selected {
+		change := view.RemoteStateConsumerChange{ConsumerName: w.Name, ConsumerID: w.ID, Status: view.RemoteStateConsumerRemove}
+		switch {
+		case w.ID == workspace.ID:
+			change.Status = view.RemoteStateConsumerSelf
+		case !existing[w.ID]:
+			change.Status = view.RemoteStateConsumerNotFound
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// PlanRemoteStateConsumerSync plans the changes that make the selected workspaces the only
+// consumers: selected workspaces that are missing are added and every other consumer is removed
+func PlanRemoteStateConsumerSync(workspace *tfe.Workspace, current []*tfe.Workspace, selected []*tfe.Workspace) []view.RemoteStateConsumerChange {
+	existing := workspaceIDs(current)
+	wanted := workspaceIDs(selected)
+
+	var changes []view.RemoteStateConsumerChange
+	for _, w := range selected {
+		change := view.RemoteStateConsumerChange{ConsumerName: w.Name, ConsumerID: w.ID, Status: view.RemoteStateConsumerAdd}
+		switch {
+		case w.ID == workspace.ID:
+			change.Status = view.RemoteStateConsumerSelf
+		case existing[w.ID]:
+			change.Status = view.RemoteStateConsumerUnchanged
+		}
+		changes = append(changes, change)
+	}
+	for _, w := range current {
+		if !wanted[w.ID] {
+			changes = append(changes, view.RemoteStateConsumerChange{ConsumerName: w.Name, ConsumerID: w.ID, Status: view.RemoteStateConsumerRemove})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].ConsumerName < changes[j].ConsumerName })
+	return changes
+}
+
+// ApplyRemoteStateConsumerChanges applies the planned additions and removals, each in a single
+// API call, and returns the changes with their final status. When a call fails, each of its
+// changes has the error and the other call is still made.
+func ApplyRemoteStateConsumerChanges(c *client.TfxClient, workspaceID string, changes []view.RemoteStateConsumerChange) []view.RemoteStateConsumerChange {
+	var add, remove []*tfe.Workspace
+	for _, change := range changes {
+		switch change.Status {
+		case view.RemoteStateConsumerAdd:
+			add = append(add, &tfe.Workspace{ID: change.ConsumerID})
+		case view.RemoteStateConsumerRemove:
+			remove = append(remove, &tfe.Workspace{ID: change.ConsumerID})
+		}
+	}
+	output.Get().Logger().Debug("Applying remote state consumer changes", "workspaceID", workspaceID, "add", len(add), "remove", len(remove))
+
+	if len(add) > 0 {
+		err := c.Client.Workspaces.AddRemoteStateConsumers(c.Context, workspaceID, tfe.WorkspaceAddRemoteStateConsumersOptions{Workspaces: add})
+		if err != nil {
+			output.Get().Logger().Error("Failed to add remote state consumers", "workspaceID", workspaceID, "error", err)
+		}
+		markRemoteStateChanges(changes, view.RemoteStateConsumerAdd, view.RemoteStateConsumerAdded, err)
+	}
+
+	if len(remove) > 0 {
+		err := c.Client.Workspaces.RemoveRemoteStateConsumers(c.Context, workspaceID, tfe.WorkspaceRemoveRemoteStateConsumersOptions{Workspaces: remove})
+		if err != nil {
+			output.Get().Logger().Error("Failed to remove remote state consumers", "workspaceID", workspaceID, "error", err)
+		}
+		markRemoteStateChanges(changes, view.RemoteStateConsumerRemove, view.RemoteStateConsumerRemoved, err)
+	}
+
+	return changes
+}
+
+// FetchRemoteStateReport reads the consumers of every workspace concurrently. Workspaces that
+// share state globally are flagged and their consumer list is not read since it has no effect.
+func FetchRemoteStateReport(c *client.TfxClient, workspaces []*tfe.Workspace, globalOnly bool, parallelism int) []view.RemoteStateReportEntry {
+	output.Get().Logger().Debug("Building remote state report", "count", len(workspaces), "globalOnly", globalOnly)
+
+	var selected []*tfe.Workspace
+	for _, w := range workspaces {
+		if !globalOnly || w.GlobalRemoteState {
+			selected = append(selected, w)
+		}
+	}
+
+	return forEachConcurrent(selected, parallelism, func(w *tfe.Workspace) view.RemoteStateReportEntry {
+		entry := view.RemoteStateReportEntry{
+			WorkspaceName:     w.Name,
+			WorkspaceID:       w.ID,
+			GlobalRemoteState: w.GlobalRemoteState,
+		}
+		if w.GlobalRemoteState {
+			entry.Warning = view.RemoteStateGlobalSharingAlert
+			return entry
+		}
+
+		consumers, err := FetchWorkspaceRemoteStateConsumers(c, w.ID)
+		if err != nil {
+			entry.Warning = err.Error()
+			return entry
+		}
+		entry.Consumers = len(consumers)
+		return entry
+	})
+}
+
+// markRemoteStateChanges sets the status of the changes applied by one call, or the error when
+// the call failed
+func markRemoteStateChanges(changes []view.RemoteStateConsumerChange, from, to string, err error) {
+	for i := range changes {
+		if changes[i].Status != from {
+			continue
+		}
+		if err != nil {
+			changes[i].Status, changes[i].Error = view.RemoteStateConsumerFailed, err.Error()
+		} else {
+			changes[i].Status = to
+		}
+	}
+}
+
+func workspaceIDs(workspaces []*tfe.Workspace) map[string]bool {
+	ids := make(map[string]bool, len(workspaces))
+	for _, w := range workspaces {
+		ids[w.ID] = true
+	}
+	return ids
+}
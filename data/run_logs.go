@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/file"
+)
+
+// RunLogBundle is everything a run produced, collected to be written to a directory or tarball.
+// Outputs that do not exist for the run, or could not be read, are listed in Skipped.
+type RunLogBundle struct {
+	Run     *tfe.Run
+	Files   []file.BundleEntry
+	Skipped []view.RunLogsSkipped
+}
+
+type runLogManifest struct {
+	RunID       string                `json:"runId"`
+	Workspace   string                `json:"workspace,omitempty"`
+	Status      string                `json:"status"`
+	CreatedAt   string                `json:"createdAt"`
+	CollectedAt string                `json:"collectedAt"`
+	Files       []string              `json:"files"`
+	Skipped     []view.RunLogsSkipped `json:"skipped,omitempty"`
+}
+
+// FetchRunLogBundle collects the run details, plan and apply logs, policy check logs, task stage
+// and policy evaluation results and the cost estimate of a run, along with a manifest.json
+// describing the bundle. Only failing to read the run itself is an error.
+func FetchRunLogBundle(c *client.TfxClient, runID string) (*RunLogBundle, error) {
+	output.Get().Logger().Debug("Fetching run log bundle", "runID", runID)
+
+	run, err := c.Client.Runs.ReadWithOptions(c.Context, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunTaskStages, tfe.RunWorkspace},
+	})
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch run", "runID", runID, "error", err)
+		return nil, err
+	}
+
+	b := &RunLogBundle{Run: run}
+	b.addAPI(c, "run.json", fmt.Sprintf("/api/v2/runs/%s", run.ID))
+
+	switch {
+	case run.Plan == nil || run.Plan.LogReadURL == "" || run.Plan.Status == tfe.PlanPending || run.Plan.Status == tfe.PlanUnreachable:
+		b.skip("plan.log", "plan did not run")
+	default:
+		b.addLogs("plan.log", func() (io.Reader, error) { return c.Client.Plans.Logs(c.Context, run.Plan.ID) })
+	}
+
+	switch {
+	case run.Apply == nil || run.Apply.LogReadURL == "" || run.Apply.Status == tfe.ApplyPending || run.Apply.Status == tfe.ApplyUnreachable:
+		b.skip("apply.log", "apply did not run")
+	default:
+		b.addLogs("apply.log", func() (io.Reader, error) { return c.Client.Applies.Logs(c.Context, run.Apply.ID) })
+	}
+
+	b.addPolicyChecks(c, run.ID)
+	b.addTaskStages(c, run.TaskStages)
+
+	if run.CostEstimate == nil || run.CostEstimate.ID == "" {
+		b.skip("cost-estimate.json", "cost estimation did not run")
+	} else {
+		b.addAPI(c, "cost-estimate.json", fmt.Sprintf("/api/v2/cost-estimates/%s", run.CostEstimate.ID))
+		b.addLogs("cost-estimate.log", func() (io.Reader, error) { return c.Client.CostEstimates.Logs(c.Context, run.CostEstimate.ID) })
+	}
+
+	if err := b.addManifest(); err != nil {
+		return nil, err
+	}
+
+	output.Get().Logger().Debug("Run log bundle fetched", "runID", runID, "files", len(b.Files), "skipped", len(b.Skipped))
+	return b, nil
+}
+
+// addPolicyChecks adds the details and logs of each legacy Sentinel policy check
+func (b *RunLogBundle) addPolicyChecks(c *client.TfxClient, runID string) {
+	checks, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.PolicyCheck, *client.Pagination, error) {
+		opts := &tfe.PolicyCheckListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		}
+		res, err := c.Client.PolicyChecks.List(c.Context, runID, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		output.Get().Logger().Warn("Failed to list policy checks", "runID", runID, "error", err)
+		b.skip("policy-checks", err.Error())
+		return
+	}
+
+	for _, pc := range checks {
+		b.addAPI(c, fmt.Sprintf("policy-checks/%s.json", pc.ID), fmt.Sprintf("/api/v2/policy-checks/%s", pc.ID))
+		b.addLogs(fmt.Sprintf("policy-checks/%s.log", pc.ID), func() (io.Reader, error) { return c.Client.PolicyChecks.Logs(c.Context, pc.ID) })
+	}
+}
+
+// addTaskStages adds each task stage with its run task results and policy evaluations, and
+// the policy set outcomes of each policy evaluation
+func (b *RunLogBundle) addTaskStages(c *client.TfxClient, stages []*tfe.TaskStage) {
+	for _, ts := range stages {
+		b.addAPI(c, fmt.Sprintf("task-stages/%s-%s.json", ts.Stage, ts.ID),
+			fmt.Sprintf("/api/v2/task-stages/%s?include=task_results,policy_evaluations", ts.ID))
+
+		evaluations, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.PolicyEvaluation, *client.Pagination, error) {
+			opts := &tfe.PolicyEvaluationListOptions{
+				ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+			}
+			res, err := c.Client.PolicyEvaluations.List(c.Context, ts.ID, opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+		})
+		if err != nil {
+			output.Get().Logger().Warn("Failed to list policy evaluations", "taskStageID", ts.ID, "error", err)
+			b.skip(fmt.Sprintf("policy-evaluations (%s)", ts.ID), err.Error())
+			continue
+		}
+		for _, e := range evaluations {
+			b.addAPI(c, fmt.Sprintf("policy-evaluations/%s.json", e.ID), fmt.Sprintf("/api/v2/policy-evaluations/%s/policy-set-outcomes", e.ID))
+		}
+	}
+}
+
+// addAPI adds the raw response of an API path to the bundle
+func (b *RunLogBundle) addAPI(c *client.TfxClient, name string, path string) {
+	body, err := fetchAPI(c, path)
+	if err != nil {
+		output.Get().Logger().Warn("Failed to fetch run output", "file", name, "error", err)
+		b.skip(name, err.Error())
+		return
+	}
+	b.Files = append(b.Files, file.BundleEntry{Name: name, Content: body})
+}
+
+// addLogs adds the logs returned by fetch to the bundle
+func (b *RunLogBundle) addLogs(name string, fetch func() (io.Reader, error)) {
+	r, err := fetch()
+	if err == nil {
+		var logs []byte
+		if logs, err = io.ReadAll(r); err == nil {
+			b.Files = append(b.Files, file.BundleEntry{Name: name, Content: logs})
+			return
+		}
+	}
+	output.Get().Logger().Warn("Failed to fetch run logs", "file", name, "error", err)
+	b.skip(name, err.Error())
+}
+
+func (b *RunLogBundle) skip(name string, reason string) {
+	b.Skipped = append(b.Skipped, view.RunLogsSkipped{Name: name, Reason: reason})
+}
+
+// addManifest adds manifest.json, listing the files in the bundle and those that were skipped
+func (b *RunLogBundle) addManifest() error {
+	m := runLogManifest{
+		RunID:       b.Run.ID,
+		Status:      string(b.Run.Status),
+		CreatedAt:   b.Run.CreatedAt.Format(time.RFC3339),
+		CollectedAt: time.Now().UTC().Format(time.RFC3339),
+		Files:       []string{},
+		Skipped:     b.Skipped,
+	}
+	if b.Run.Workspace != nil {
+		m.Workspace = b.Run.Workspace.Name
+	}
+	for _, f := range b.Files {
+		m.Files = append(m.Files, f.Name)
+	}
+
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	b.Files = append(b.Files, file.BundleEntry{Name: "manifest.json", Content: append(content, '\n')})
+	return nil
+}
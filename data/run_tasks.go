@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"fmt"
+	"slices"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// FetchRunTaskResults reads the run task results of each task stage of a run, in stage order.
+// Task stages that can not be read are logged and skipped, so runs on installations without
+// run tasks are still shown.
+func FetchRunTaskResults(c *client.TfxClient, runID string) []*tfe.TaskResult {
+	log := output.Get().Logger()
+	log.Debug("Fetching run task results", "runID", runID)
+
+	stages, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.TaskStage, *client.Pagination, error) {
+		res, err := c.Client.TaskStages.List(c.Context, runID, &tfe.TaskStageListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		log.Warn("Failed to list task stages, run task results are excluded", "runID", runID, "error", err)
+		return nil
+	}
+
+	var results []*tfe.TaskResult
+	for _, ts := range stages {
+		stage, err := c.Client.TaskStages.Read(c.Context, ts.ID, &tfe.TaskStageReadOptions{
+			Include: []tfe.TaskStageIncludeOpt{tfe.TaskStageTaskResults},
+		})
+		if err != nil {
+			log.Warn("Failed to read task stage", "id", ts.ID, "error", err)
+			continue
+		}
+		for _, tr := range stage.TaskResults {
+			// The included result only references its stage by id
+			tr.TaskStage = stage
+			results = append(results, tr)
+		}
+	}
+	return results
+}
+
+// FetchRunTasks lists the run tasks of an organization
+func FetchRunTasks(c *client.TfxClient, orgName string) ([]*tfe.RunTask, error) {
+	output.Get().Logger().Debug("Fetching run tasks", "organization", orgName)
+
+	tasks, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.RunTask, *client.Pagination, error) {
+		res, err := c.Client.RunTasks.List(c.Context, orgName, &tfe.RunTaskListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+			Include:     []tfe.RunTaskIncludeOpt{tfe.RunTaskWorkspaceTasks},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		output.Get().Logger().Error("Failed to list run tasks", "organization", orgName, "error", err)
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// FetchRunTaskByName finds a run task of an organization by its name
+func FetchRunTaskByName(c *client.TfxClient, orgName string, name string) (*tfe.RunTask, error) {
+	tasks, err := FetchRunTasks(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tasks {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("run task '%s' not found in organization '%s'", name, orgName)
+}
+
+// CreateRunTask creates a run task in an organization
+func CreateRunTask(c *client.TfxClient, orgName string, options tfe.RunTaskCreateOptions) (*tfe.RunTask, error) {
+	output.Get().Logger().Debug("Creating run task", "organization", orgName, "name", options.Name)
+
+	task, err := c.Client.RunTasks.Create(c.Context, orgName, options)
+	if err != nil {
+		output.Get().Logger().Error("Failed to create run task", "name", options.Name, "error", err)
+		return nil, err
+	}
+	return task, nil
+}
+
+// AttachRunTask attaches a run task to each workspace, reading workspaces concurrently. A
+// workspace that already has the task is updated when its enforcement level or stages differ.
+func AttachRunTask(c *client.TfxClient, workspaces []*tfe.Workspace, task *tfe.RunTask, enforcementLevel string, stages []string, parallelism int) []view.RunTaskAttachResult {
+	output.Get().Logger().Debug("Attaching run task", "name", task.Name, "count", len(workspaces))
+
+	taskStages := make([]tfe.Stage, len(stages))
+	for i, s := range stages {
+		taskStages[i] = tfe.Stage(s)
+	}
+	level := tfe.TaskEnforcementLevel(enforcementLevel)
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.RunTaskAttachResult {
+		result := view.RunTaskAttachResult{WorkspaceName: w.Name, WorkspaceID: w.ID}
+
+		existing, err := fetchWorkspaceRunTask(c, w.ID, task.ID)
+		if err != nil {
+			result.Status, result.Error = view.RunTaskFailed, err.Error()
+			return result
+		}
+
+		if existing != nil {
+			result.WorkspaceTaskID = existing.ID
+			if existing.EnforcementLevel == level && sameStages(existing.Stages, taskStages) {
+				result.Status = view.RunTaskAlreadyAttached
+				return result
+			}
+			_, err := c.Client.WorkspaceRunTasks.Update(c.Context, w.ID, existing.ID, tfe.WorkspaceRunTaskUpdateOptions{
+				EnforcementLevel: level,
+				Stages:           &taskStages,
+			})
+			if err != nil {
+				result.Status, result.Error = view.RunTaskFailed, err.Error()
+				return result
+			}
+			result.Status = view.RunTaskUpdated
+			return result
+		}
+
+		wrt, err := c.Client.WorkspaceRunTasks.Create(c.Context, w.ID, tfe.WorkspaceRunTaskCreateOptions{
+			EnforcementLevel: level,
+			RunTask:          task,
+			Stages:           &taskStages,
+		})
+		if err != nil {
+			result.Status, result.Error = view.RunTaskFailed, err.Error()
+			return result
+		}
+		result.WorkspaceTaskID = wrt.ID
+		result.Status = view.RunTaskAttached
+		return result
+	})
+}
+
+// DetachRunTask removes a run task from each workspace, reading workspaces concurrently
+func DetachRunTask(c *client.TfxClient, workspaces []*tfe.Workspace, task *tfe.RunTask, parallelism int) []view.RunTaskAttachResult {
+	output.Get().Logger().Debug("Detaching run task", "name", task.Name, "count", len(workspaces))
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.RunTaskAttachResult {
+		result := view.RunTaskAttachResult{WorkspaceName: w.Name, WorkspaceID: w.ID}
+
+		existing, err := fetchWorkspaceRunTask(c, w.ID, task.ID)
+		if err != nil {
+			result.Status, result.Error = view.RunTaskFailed, err.Error()
+			return result
+		}
+		if existing == nil {
+			result.Status = view.RunTaskNotAttached
+			return result
+		}
+
+		result.WorkspaceTaskID = existing.ID
+		if err := c.Client.WorkspaceRunTasks.Delete(c.Context, w.ID, existing.ID); err != nil {
+			result.Status, result.Error = view.RunTaskFailed, err.Error()
+			return result
+		}
+		result.Status = view.RunTaskDetached
+		return result
+	})
+}
+
+func sameStages(a, b []tfe.Stage) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// fetchWorkspaceRunTask returns the workspace run task that attaches a run task to a
+// workspace, or nil when it is not attached
+func fetchWorkspaceRunTask(c *client.TfxClient, workspaceID string, taskID string) (*tfe.WorkspaceRunTask, error) {
+	wrts, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.WorkspaceRunTask, *client.Pagination, error) {
+		res, err := c.Client.WorkspaceRunTasks.List(c.Context, workspaceID, &tfe.WorkspaceRunTaskListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, wrt := range wrts {
+		if wrt.RunTask != nil && wrt.RunTask.ID == taskID {
+			return wrt, nil
+		}
+	}
+	return nil, nil
+}
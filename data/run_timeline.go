@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/output"
+)
+
+// FetchRunForTimeline reads a run with the relations its timeline is built from, plus any extra
+// includes, along with its legacy policy checks. Policy checks that can not be listed are logged
+// and skipped, so the timeline is still shown without them.
+func FetchRunForTimeline(c *client.TfxClient, runID string, include ...tfe.RunIncludeOpt) (*tfe.Run, []*tfe.PolicyCheck, error) {
+	output.Get().Logger().Debug("Fetching run for timeline", "runID", runID)
+
+	run, err := c.Client.Runs.ReadWithOptions(c.Context, runID, &tfe.RunReadOptions{
+		Include: append([]tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunTaskStages, tfe.RunWorkspace}, include...),
+	})
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch run", "runID", runID, "error", err)
+		return nil, nil, err
+	}
+
+	checks, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.PolicyCheck, *client.Pagination, error) {
+		res, err := c.Client.PolicyChecks.List(c.Context, runID, &tfe.PolicyCheckListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.Items, client.NewPaginationFromTFE(res.Pagination), nil
+	})
+	if err != nil {
+		output.Get().Logger().Warn("Failed to list policy checks, timeline excludes them", "runID", runID, "error", err)
+		checks = nil
+	}
+
+	return run, checks, nil
+}
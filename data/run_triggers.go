@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// FetchRunTriggers fetches all run triggers for a workspace. Inbound triggers queue runs in the
+// workspace, outbound triggers are the workspaces it queues runs in.
+func FetchRunTriggers(c *client.TfxClient, workspaceID string, direction tfe.RunTriggerFilterOp) ([]*tfe.RunTrigger, error) {
+	output.Get().Logger().Debug("Fetching run triggers", "workspaceID", workspaceID, "direction", direction)
+
+	return client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.RunTrigger, *client.Pagination, error) {
+		output.Get().Logger().Trace("Fetching run triggers page", "workspaceID", workspaceID, "page", pageNumber)
+
+		opts := &tfe.RunTriggerListOptions{
+			ListOptions:    tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+			RunTriggerType: direction,
+		}
+
+		result, err := c.Client.RunTriggers.List(c.Context, workspaceID, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to fetch run triggers page", "workspaceID", workspaceID, "page", pageNumber, "error", err)
+			return nil, nil, err
+		}
+
+		output.Get().Logger().Trace("Run triggers page fetched", "workspaceID", workspaceID, "page", pageNumber, "count", len(result.Items))
+		return result.Items, client.NewPaginationFromTFE(result.Pagination), nil
+	})
+}
+
+// AddRunTriggers adds each source workspace as a run trigger for the workspace.
+// Sources that already trigger the workspace are skipped.
+func AddRunTriggers(c *client.TfxClient, workspace *tfe.Workspace, sources []*tfe.Workspace) ([]view.RunTriggerResult, error) {
+	output.Get().Logger().Debug("Adding run triggers", "workspaceID", workspace.ID, "count", len(sources))
+
+	existing, err := FetchRunTriggers(c, workspace.ID, tfe.RunTriggerInbound)
+	if err != nil {
+		return nil, err
+	}
+	bySource := runTriggersBySource(existing)
+
+	results := make([]view.RunTriggerResult, len(sources))
+	for i, s := range sources {
+		results[i] = view.RunTriggerResult{Source: s.Name}
+		if s.ID == workspace.ID {
+			results[i].Status = view.RunTriggerSelf
+			continue
+		}
+		if rt, ok := bySource[s.Name]; ok {
+			results[i].RunTriggerID = rt.ID
+			results[i].Status = view.RunTriggerAlreadyExists
+			continue
+		}
+
+		rt, err := c.Client.RunTriggers.Create(c.Context, workspace.ID, tfe.RunTriggerCreateOptions{Sourceable: s})
+		if err != nil {
+			output.Get().Logger().Error("Failed to create run trigger", "workspaceID", workspace.ID, "sourceID", s.ID, "error", err)
+			results[i].Status = err.Error()
+			continue
+		}
+		results[i].RunTriggerID = rt.ID
+		results[i].Status = view.RunTriggerAdded
+	}
+	return results, nil
+}
+
+// RemoveRunTriggers removes the run triggers from each named source workspace.
+// Sources that do not trigger the workspace are skipped.
+func RemoveRunTriggers(c *client.TfxClient, workspace *tfe.Workspace, sourceNames []string) ([]view.RunTriggerResult, error) {
+	output.Get().Logger().Debug("Removing run triggers", "workspaceID", workspace.ID, "count", len(sourceNames))
+
+	existing, err := FetchRunTriggers(c, workspace.ID, tfe.RunTriggerInbound)
+	if err != nil {
+		return nil, err
+	}
+	bySource := runTriggersBySource(existing)
+
+	results := make([]view.RunTriggerResult, len(sourceNames))
+	for i, name := range sourceNames {
+		results[i] = view.RunTriggerResult{Source: name}
+		rt, ok := bySource[name]
+		if !ok {
+			results[i].Status = view.RunTriggerNotFound
+			continue
+		}
+
+		results[i].RunTriggerID = rt.ID
+		if err := c.Client.RunTriggers.Delete(c.Context, rt.ID); err != nil {
+			output.Get().Logger().Error("Failed to delete run trigger", "runTriggerID", rt.ID, "error", err)
+			results[i].Status = err.Error()
+			continue
+		}
+		results[i].Status = view.RunTriggerRemoved
+	}
+	return results, nil
+}
+
+func runTriggersBySource(triggers []*tfe.RunTrigger) map[string]*tfe.RunTrigger {
+	bySource := make(map[string]*tfe.RunTrigger, len(triggers))
+	for _, rt := range triggers {
+		bySource[rt.SourceableName] = rt
+	}
+	return bySource
+}
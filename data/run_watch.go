@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// Run status polling backs off from the minimum to the maximum delay while the status is unchanged
+const (
+	runPollMinDelay = 1 * time.Second
+	runPollMaxDelay = 10 * time.Second
+)
+
+// prePlanStatuses are run statuses before the plan starts, so there are no plan logs yet
+var prePlanStatuses = []tfe.RunStatus{
+	tfe.RunPending,
+	tfe.RunFetching,
+	tfe.RunFetchingCompleted,
+	tfe.RunQueuing,
+	tfe.RunPlanQueued,
+	tfe.RunPrePlanRunning,
+	tfe.RunPrePlanCompleted,
+}
+
+// applyStatuses are run statuses once the apply has started
+var applyStatuses = []tfe.RunStatus{
+	tfe.RunApplying,
+	tfe.RunApplied,
+	tfe.RunPostApplyRunning,
+	tfe.RunPostApplyCompleted,
+}
+
+// RunOutcome classifies a run into one of the view.RunOutcome values and returns false while
+// the run is still in progress. A run waiting for confirmation is finished with changes since
+// it will not progress on its own.
+func RunOutcome(run *tfe.Run) (string, bool) {
+	switch run.Status {
+	case tfe.RunApplied:
+		return view.RunOutcomeApplied, true
+	case tfe.RunPlannedAndFinished, tfe.RunPlannedAndSaved:
+		if run.HasChanges {
+			return view.RunOutcomePlannedWithChanges, true
+		}
+		return view.RunOutcomePlannedNoChanges, true
+	case tfe.RunErrored, tfe.RunPolicySoftFailed, tfe.RunPolicyOverride:
+		return view.RunOutcomeErrored, true
+	case tfe.RunCanceled, tfe.RunDiscarded:
+		return view.RunOutcomeCanceled, true
+	}
+
+	if run.Actions != nil && run.Actions.IsConfirmable && !run.AutoApply {
+		return view.RunOutcomePlannedWithChanges, true
+	}
+	if run.Status == tfe.RunPostPlanAwaitingDecision {
+		return view.RunOutcomePlannedWithChanges, true
+	}
+	return "", false
+}
+
+// WaitForRun polls a run until the condition is true, backing off while its status is unchanged
+func WaitForRun(c *client.TfxClient, runID string, until func(*tfe.Run) bool) (*tfe.Run, error) {
+	delay := runPollMinDelay
+	var lastStatus tfe.RunStatus
+	for {
+		run, err := FetchRun(c, runID)
+		if err != nil {
+			return nil, err
+		}
+		if until(run) {
+			return run, nil
+		}
+
+		if run.Status != lastStatus {
+			output.Get().Logger().Debug("Run status changed", "runID", runID, "status", run.Status)
+			lastStatus = run.Status
+			delay = runPollMinDelay
+		}
+		select {
+		case <-c.Context.Done():
+			return nil, c.Context.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, runPollMaxDelay)
+	}
+}
+
+// WatchRun waits for a run to finish and returns it with its outcome. When onLine is set the
+// plan logs and then the apply logs are streamed to it line by line as they are written.
+func WatchRun(c *client.TfxClient, runID string, onLine func(string)) (*tfe.Run, string, error) {
+	output.Get().Logger().Debug("Watching run", "runID", runID)
+
+	finished := func(r *tfe.Run) bool {
+		_, done := RunOutcome(r)
+		return done
+	}
+
+	run, err := WaitForRun(c, runID, func(r *tfe.Run) bool {
+		return finished(r) || !slices.Contains(prePlanStatuses, r.Status)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if onLine != nil && run.Plan != nil {
+		if err := streamPlanLogs(c, run.Plan.ID, onLine); err != nil {
+			return nil, "", errors.Wrap(err, "failed to stream plan logs")
+		}
+	}
+
+	run, err = WaitForRun(c, runID, func(r *tfe.Run) bool {
+		return finished(r) || slices.Contains(applyStatuses, r.Status)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if onLine != nil && run.Apply != nil {
+		if err := streamApplyLogs(c, run.Apply.ID, onLine); err != nil {
+			return nil, "", errors.Wrap(err, "failed to stream apply logs")
+		}
+	}
+
+	run, err = WaitForRun(c, runID, finished)
+	if err != nil {
+		return nil, "", err
+	}
+	outcome, _ := RunOutcome(run)
+	output.Get().Logger().Debug("Run finished", "runID", runID, "status", run.Status, "outcome", outcome)
+	return run, outcome, nil
+}
+
+// streamPlanLogs tails the logs of a plan until it finishes. Plans that never started have no
+// logs and are skipped.
+func streamPlanLogs(c *client.TfxClient, planID string, onLine func(string)) error {
+	plan, err := FetchPlan(c, planID)
+	if err != nil {
+		return err
+	}
+	if plan.LogReadURL == "" || plan.Status == tfe.PlanPending || plan.Status == tfe.PlanUnreachable {
+		return nil
+	}
+
+	logs, err := c.Client.Plans.Logs(c.Context, planID)
+	if err != nil {
+		return err
+	}
+	return scanLogLines(logs, onLine)
+}
+
+// streamApplyLogs tails the logs of an apply until it finishes. Applies that never started,
+// such as for plan only or discarded runs, have no logs and are skipped.
+func streamApplyLogs(c *client.TfxClient, applyID string, onLine func(string)) error {
+	apply, err := c.Client.Applies.Read(c.Context, applyID)
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch apply", "applyID", applyID, "error", err)
+		return err
+	}
+	if apply.LogReadURL == "" || apply.Status == tfe.ApplyPending || apply.Status == tfe.ApplyUnreachable {
+		return nil
+	}
+
+	logs, err := c.Client.Applies.Logs(c.Context, applyID)
+	if err != nil {
+		return err
+	}
+	return scanLogLines(logs, onLine)
+}
+
+func scanLogLines(r io.Reader, onLine func(string)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+	return scanner.Err()
+}
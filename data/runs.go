@@ -4,6 +4,8 @@
 package data
 
 import (
+	"strings"
+
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/straubt1/tfx/client"
 	"github.com/straubt1/tfx/output"
@@ -140,3 +142,76 @@ func GetLatestRunID(c *client.TfxClient, workspaceID string) (string, error) {
 	}
 	return res.Items[0].ID, nil
 }
+
+// confirmableRunStatuses are the statuses a run can wait for confirmation in
+var confirmableRunStatuses = []string{
+	string(tfe.RunPlanned),
+	string(tfe.RunCostEstimated),
+	string(tfe.RunPolicyChecked),
+	string(tfe.RunPostPlanCompleted),
+}
+
+// FetchRunsNeedingConfirmation lists the runs across the organization that are waiting for
+// confirmation, limited to the named workspaces when any are given
+func FetchRunsNeedingConfirmation(c *client.TfxClient, orgName string, workspaceNames []string) ([]*tfe.Run, error) {
+	output.Get().Logger().Debug("Fetching runs needing confirmation", "organization", orgName, "workspaces", workspaceNames)
+
+	runs, err := client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.Run, *client.Pagination, error) {
+		output.Get().Logger().Trace("Fetching organization runs page", "organization", orgName, "page", pageNumber)
+
+		opts := &tfe.RunListForOrganizationOptions{
+			ListOptions:    tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+			Status:         strings.Join(confirmableRunStatuses, ","),
+			WorkspaceNames: strings.Join(workspaceNames, ","),
+			Include:        []tfe.RunIncludeOpt{tfe.RunWorkspace},
+		}
+
+		result, err := c.Client.Runs.ListForOrganization(c.Context, orgName, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to fetch organization runs page", "organization", orgName, "page", pageNumber, "error", err)
+			return nil, nil, err
+		}
+
+		output.Get().Logger().Trace("Organization runs page fetched", "organization", orgName, "page", pageNumber, "count", len(result.Items))
+		return result.Items, client.NewPaginationFromNextPrev(result.PaginationNextPrev), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The status filter also matches runs that auto-apply or are plan only
+	var pending []*tfe.Run
+	for _, r := range runs {
+		if r.Actions != nil && r.Actions.IsConfirmable {
+			pending = append(pending, r)
+		}
+	}
+	return pending, nil
+}
+
+// ApplyRun confirms a run that is waiting for confirmation so it applies
+func ApplyRun(c *client.TfxClient, runID string, comment string) error {
+	output.Get().Logger().Debug("Applying run", "runID", runID)
+
+	opts := tfe.RunApplyOptions{}
+	if comment != "" {
+		opts.Comment = tfe.String(comment)
+	}
+	if err := c.Client.Runs.Apply(c.Context, runID, opts); err != nil {
+		output.Get().Logger().Error("Failed to apply run", "runID", runID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// CreateRunComment adds a comment to a run
+func CreateRunComment(c *client.TfxClient, runID string, body string) (*tfe.Comment, error) {
+	output.Get().Logger().Debug("Creating run comment", "runID", runID)
+
+	comment, err := c.Client.Comments.Create(c.Context, runID, tfe.CommentCreateOptions{Body: body})
+	if err != nil {
+		output.Get().Logger().Error("Failed to create run comment", "runID", runID, "error", err)
+		return nil, err
+	}
+	return comment, nil
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// workspaceActivity is the most recent activity found for a workspace
+type workspaceActivity struct {
+	at   time.Time
+	kind string
+}
+
+func (a *workspaceActivity) observe(t time.Time, kind string) {
+	if t.After(a.at) {
+		a.at = t
+		a.kind = kind
+	}
+}
+
+// FetchStaleWorkspaces finds the workspaces with no runs, state versions or configuration
+// versions since the cutoff, reading workspaces concurrently. Workspaces created after the
+// cutoff are never stale.
+func FetchStaleWorkspaces(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, cutoff time.Time, parallelism int) ([]view.StaleWorkspace, error) {
+	output.Get().Logger().Debug("Finding stale workspaces", "organization", orgName, "count", len(workspaces), "cutoff", cutoff)
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := FetchTeams(c, orgName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list teams")
+	}
+	teamNames := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNames[t.ID] = t.Name
+	}
+
+	type result struct {
+		stale    bool
+		activity workspaceActivity
+		owners   []string
+		err      error
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		if w.CreatedAt.After(cutoff) {
+			return result{}
+		}
+
+		activity, err := fetchLastActivity(c, orgName, w, cutoff)
+		if err != nil {
+			return result{err: err}
+		}
+		if activity.at.After(cutoff) {
+			return result{}
+		}
+
+		access, err := FetchWorkspaceTeamAccess(c, w.ID, 0)
+		if err != nil {
+			return result{err: errors.Wrapf(err, "failed to list team access for %s", w.Name)}
+		}
+		owners := []string{}
+		for _, ta := range access {
+			if ta.Access == tfe.AccessAdmin && ta.Team != nil {
+				owners = append(owners, teamNames[ta.Team.ID])
+			}
+		}
+		return result{stale: true, activity: activity, owners: owners}
+	})
+
+	now := time.Now()
+	var stale []view.StaleWorkspace
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		if !r.stale {
+			continue
+		}
+
+		w := workspaces[i]
+		s := view.StaleWorkspace{
+			WorkspaceName: w.Name,
+			WorkspaceID:   w.ID,
+			CreatedAt:     w.CreatedAt,
+			ResourceCount: w.ResourceCount,
+			Locked:        w.Locked,
+			OwnerTeams:    r.owners,
+		}
+		if w.Project != nil {
+			s.ProjectName = projectNames[w.Project.ID]
+		}
+
+		since := w.CreatedAt
+		if !r.activity.at.IsZero() {
+			at := r.activity.at
+			s.LastActivity = &at
+			s.LastActivityType = r.activity.kind
+			since = at
+		}
+		s.DaysInactive = int(now.Sub(since).Hours() / 24)
+		stale = append(stale, s)
+	}
+	return stale, nil
+}
+
+// fetchLastActivity finds the latest run, state version and configuration version of a
+// workspace. Once any of them is after the cutoff the rest are not read.
+func fetchLastActivity(c *client.TfxClient, orgName string, w *tfe.Workspace, cutoff time.Time) (workspaceActivity, error) {
+	var activity workspaceActivity
+
+	runs, err := FetchRunsForWorkspace(c, w.ID, 1)
+	if err != nil {
+		return activity, errors.Wrapf(err, "failed to list runs for %s", w.Name)
+	}
+	if len(runs) > 0 {
+		activity.observe(runs[0].CreatedAt, view.StaleActivityRun)
+	}
+	if activity.at.After(cutoff) {
+		return activity, nil
+	}
+
+	stateVersions, err := FetchStateVersions(c, orgName, w.Name, 1)
+	if err != nil {
+		return activity, errors.Wrapf(err, "failed to list state versions for %s", w.Name)
+	}
+	if len(stateVersions) > 0 {
+		activity.observe(stateVersions[0].CreatedAt, view.StaleActivityStateVersion)
+	}
+	if activity.at.After(cutoff) {
+		return activity, nil
+	}
+
+	configVersions, err := FetchConfigurationVersions(c, orgName, w.Name, 1)
+	if err != nil {
+		return activity, errors.Wrapf(err, "failed to list configuration versions for %s", w.Name)
+	}
+	if len(configVersions) > 0 {
+		activity.observeConfigurationVersion(configVersions[0])
+	}
+	return activity, nil
+}
+
+// observeConfigurationVersion records when a configuration version was uploaded and processed.
+// The archive time is ignored, the platform archives old configuration versions on its own.
+func (a *workspaceActivity) observeConfigurationVersion(cv *tfe.ConfigurationVersion) {
+	if cv.StatusTimestamps == nil {
+		return
+	}
+	ts := cv.StatusTimestamps
+	for _, t := range []time.Time{ts.QueuedAt, ts.StartedAt, ts.FinishedAt} {
+		a.observe(t, view.StaleActivityConfigurationVersion)
+	}
+}
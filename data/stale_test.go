@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	view "github.com/straubt1/tfx/cmd/views"
+)
+
+func TestWorkspaceActivity_ObserveConfigurationVersion(t *testing.T) {
+	uploaded := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	archived := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		cv   *tfe.ConfigurationVersion
+		want time.Time
+	}{
+		{
+			name: "no timestamps",
+			cv:   &tfe.ConfigurationVersion{},
+		},
+		{
+			name: "latest user-driven timestamp",
+			cv: &tfe.ConfigurationVersion{StatusTimestamps: &tfe.CVStatusTimestamps{
+				QueuedAt:   uploaded.Add(-time.Minute),
+				StartedAt:  uploaded.Add(-30 * time.Second),
+				FinishedAt: uploaded,
+			}},
+			want: uploaded,
+		},
+		{
+			name: "archive time is not activity",
+			cv: &tfe.ConfigurationVersion{StatusTimestamps: &tfe.CVStatusTimestamps{
+				FinishedAt: uploaded,
+				ArchivedAt: archived,
+			}},
+			want: uploaded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a workspaceActivity
+			a.observeConfigurationVersion(tt.cv)
+			if !a.at.Equal(tt.want) {
+				t.Errorf("at = %v, want %v", a.at, tt.want)
+			}
+			if !tt.want.IsZero() && a.kind != view.StaleActivityConfigurationVersion {
+				t.Errorf("kind = %q, want %q", a.kind, view.StaleActivityConfigurationVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// TeamAccessParams is the access a team is granted on a workspace.
+// Permissions are only sent when Access is custom, empty and nil values are left to the API
+// defaults when adding and unchanged when updating.
+type TeamAccessParams struct {
+	Access           string
+	Runs             string
+	Variables        string
+	StateVersions    string
+	SentinelMocks    string
+	WorkspaceLocking *bool
+	RunTasks         *bool
+}
+
+func (p TeamAccessParams) addOptions() tfe.TeamAccessAddOptions {
+	opts := tfe.TeamAccessAddOptions{Access: tfe.Access(tfe.AccessType(p.Access))}
+	if p.Access == string(tfe.AccessCustom) {
+		opts.Runs, opts.Variables, opts.StateVersions, opts.SentinelMocks = p.permissions()
+		opts.WorkspaceLocking = p.WorkspaceLocking
+		opts.RunTasks = p.RunTasks
+	}
+	return opts
+}
+
+func (p TeamAccessParams) updateOptions() tfe.TeamAccessUpdateOptions {
+	opts := tfe.TeamAccessUpdateOptions{Access: tfe.Access(tfe.AccessType(p.Access))}
+	if p.Access == string(tfe.AccessCustom) {
+		opts.Runs, opts.Variables, opts.StateVersions, opts.SentinelMocks = p.permissions()
+		opts.WorkspaceLocking = p.WorkspaceLocking
+		opts.RunTasks = p.RunTasks
+	}
+	return opts
+}
+
+func (p TeamAccessParams) permissions() (*tfe.RunsPermissionType, *tfe.VariablesPermissionType, *tfe.StateVersionsPermissionType, *tfe.SentinelMocksPermissionType) {
+	var runs *tfe.RunsPermissionType
+	var variables *tfe.VariablesPermissionType
+	var state *tfe.StateVersionsPermissionType
+	var mocks *tfe.SentinelMocksPermissionType
+	if p.Runs != "" {
+		runs = tfe.RunsPermission(tfe.RunsPermissionType(p.Runs))
+	}
+	if p.Variables != "" {
+		variables = tfe.VariablesPermission(tfe.VariablesPermissionType(p.Variables))
+	}
+	if p.StateVersions != "" {
+		state = tfe.StateVersionsPermission(tfe.StateVersionsPermissionType(p.StateVersions))
+	}
+	if p.SentinelMocks != "" {
+		mocks = tfe.SentinelMocksPermission(tfe.SentinelMocksPermissionType(p.SentinelMocks))
+	}
+	return runs, variables, state, mocks
+}
+
+// FetchTeamByName fetches a team by exact name in the specified organization
+func FetchTeamByName(c *client.TfxClient, orgName string, teamName string) (*tfe.Team, error) {
+	output.Get().Logger().Debug("Fetching team by name", "organization", orgName, "teamName", teamName)
+
+	result, err := c.Client.Teams.List(c.Context, orgName, &tfe.TeamListOptions{Names: []string{teamName}})
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch team", "organization", orgName, "teamName", teamName, "error", err)
+		return nil, err
+	}
+	for _, t := range result.Items {
+		if t.Name == teamName {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("team %q not found", teamName)
+}
+
+// FetchWorkspaceTeamAccessForTeam returns the team access a team has on a workspace, or nil if it has none
+func FetchWorkspaceTeamAccessForTeam(c *client.TfxClient, workspaceID string, teamID string) (*tfe.TeamAccess, error) {
+	access, err := FetchWorkspaceTeamAccess(c, workspaceID, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, ta := range access {
+		if ta.Team != nil && ta.Team.ID == teamID {
+			return ta, nil
+		}
+	}
+	return nil, nil
+}
+
+// AddTeamAccess grants a team access to every workspace concurrently.
+// Workspaces where the team already has access are skipped.
+func AddTeamAccess(c *client.TfxClient, workspaces []*tfe.Workspace, team *tfe.Team, params TeamAccessParams, parallelism int) []view.TeamAccessResult {
+	output.Get().Logger().Debug("Adding team access", "team", team.Name, "count", len(workspaces), "access", params.Access)
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.TeamAccessResult {
+		result := view.TeamAccessResult{WorkspaceName: w.Name, WorkspaceID: w.ID, TeamName: team.Name}
+
+		existing, err := FetchWorkspaceTeamAccessForTeam(c, w.ID, team.ID)
+		if err != nil {
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+		if existing != nil {
+			result.Access = string(existing.Access)
+			result.Status = view.TeamAccessAlreadyExists
+			return result
+		}
+
+		opts := params.addOptions()
+		opts.Team = team
+		opts.Workspace = w
+		ta, err := c.Client.TeamAccess.Add(c.Context, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to add team access", "workspaceID", w.ID, "teamID", team.ID, "error", err)
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+
+		result.Access = string(ta.Access)
+		result.Status = view.TeamAccessAdded
+		return result
+	})
+}
+
+// UpdateTeamAccess changes the access a team has on every workspace concurrently.
+// Workspaces where the team has no access are skipped.
+func UpdateTeamAccess(c *client.TfxClient, workspaces []*tfe.Workspace, team *tfe.Team, params TeamAccessParams, parallelism int) []view.TeamAccessResult {
+	output.Get().Logger().Debug("Updating team access", "team", team.Name, "count", len(workspaces), "access", params.Access)
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.TeamAccessResult {
+		result := view.TeamAccessResult{WorkspaceName: w.Name, WorkspaceID: w.ID, TeamName: team.Name}
+
+		existing, err := FetchWorkspaceTeamAccessForTeam(c, w.ID, team.ID)
+		if err != nil {
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+		if existing == nil {
+			result.Status = view.TeamAccessNotFound
+			return result
+		}
+
+		ta, err := c.Client.TeamAccess.Update(c.Context, existing.ID, params.updateOptions())
+		if err != nil {
+			output.Get().Logger().Error("Failed to update team access", "teamAccessID", existing.ID, "error", err)
+			result.Access = string(existing.Access)
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+
+		result.Access = string(ta.Access)
+		result.Status = view.TeamAccessUpdated
+		return result
+	})
+}
+
+// RemoveTeamAccess removes a team's access from every workspace concurrently.
+// Workspaces where the team has no access are skipped.
+func RemoveTeamAccess(c *client.TfxClient, workspaces []*tfe.Workspace, team *tfe.Team, parallelism int) []view.TeamAccessResult {
+	output.Get().Logger().Debug("Removing team access", "team", team.Name, "count", len(workspaces))
+
+	return forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) view.TeamAccessResult {
+		result := view.TeamAccessResult{WorkspaceName: w.Name, WorkspaceID: w.ID, TeamName: team.Name}
+
+		existing, err := FetchWorkspaceTeamAccessForTeam(c, w.ID, team.ID)
+		if err != nil {
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+		if existing == nil {
+			result.Status = view.TeamAccessNotFound
+			return result
+		}
+
+		if err := c.Client.TeamAccess.Remove(c.Context, existing.ID); err != nil {
+			output.Get().Logger().Error("Failed to remove team access", "teamAccessID", existing.ID, "error", err)
+			result.Access = string(existing.Access)
+			result.Status, result.Error = view.TeamAccessFailed, err.Error()
+			return result
+		}
+
+		result.Access = string(existing.Access)
+		result.Status = view.TeamAccessRemoved
+		return result
+	})
+}
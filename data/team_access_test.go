@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func TestTeamAccessParams_UpdateOptions(t *testing.T) {
+	// Permissions that were not supplied are not sent, so update leaves them unchanged
+	opts := TeamAccessParams{Access: "custom", Runs: "plan"}.updateOptions()
+	if opts.WorkspaceLocking != nil || opts.RunTasks != nil || opts.Variables != nil {
+		t.Errorf("updateOptions() = %+v, want unset permissions left nil", opts)
+	}
+	if opts.Runs == nil || *opts.Runs != tfe.RunsPermissionPlan {
+		t.Errorf("updateOptions() runs = %v, want plan", opts.Runs)
+	}
+
+	locking := false
+	opts = TeamAccessParams{Access: "custom", WorkspaceLocking: &locking}.updateOptions()
+	if opts.WorkspaceLocking == nil || *opts.WorkspaceLocking {
+		t.Errorf("updateOptions() workspace locking = %v, want false", opts.WorkspaceLocking)
+	}
+
+	// Permissions are only sent for custom access
+	opts = TeamAccessParams{Access: "write", WorkspaceLocking: &locking}.updateOptions()
+	if opts.WorkspaceLocking != nil {
+		t.Errorf("updateOptions() sent workspace locking for write access")
+	}
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	"github.com/straubt1/tfx/output"
+)
+
+// FetchTeams fetches all teams for a given organization using pagination
+func FetchTeams(c *client.TfxClient, orgName string) ([]*tfe.Team, error) {
+	output.Get().Logger().Debug("Fetching teams", "organization", orgName)
+
+	return client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.Team, *client.Pagination, error) {
+		output.Get().Logger().Trace("Fetching teams page", "organization", orgName, "page", pageNumber)
+
+		opts := &tfe.TeamListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		}
+
+		result, err := c.Client.Teams.List(c.Context, orgName, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to fetch teams page", "organization", orgName, "page", pageNumber, "error", err)
+			return nil, nil, err
+		}
+
+		output.Get().Logger().Trace("Teams page fetched", "organization", orgName, "page", pageNumber, "count", len(result.Items))
+		return result.Items, client.NewPaginationFromTFE(result.Pagination), nil
+	})
+}
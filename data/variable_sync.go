@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+	"github.com/straubt1/tfx/pkg/varfile"
+)
+
+// PlanVariableSync compares the variables read from files with the variables of a workspace.
+// A variable is matched by key and category. Sensitive values can not be read, so a sensitive
+// variable in the files is always updated. Variables in the prune categories that are missing
+// from the files are deleted, except sensitive variables since secrets are kept out of the
+// files. Only the categories of the files that were read should be pruned.
+func PlanVariableSync(desired []varfile.Variable, existing []*tfe.Variable, pruneCategories []string) []view.VariableSyncChange {
+	byKey := make(map[string]*tfe.Variable, len(existing))
+	for _, v := range existing {
+		byKey[string(v.Category)+"/"+v.Key] = v
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	var changes []view.VariableSyncChange
+	for _, d := range desired {
+		id := d.Category + "/" + d.Key
+		wanted[id] = true
+
+		change := view.VariableSyncChange{Key: d.Key, Category: d.Category, Value: d.Value, HCL: d.HCL, Status: view.VariableSyncCreate}
+		if v, ok := byKey[id]; ok {
+			change.VariableID = v.ID
+			change.Sensitive = v.Sensitive
+			switch {
+			case v.Sensitive:
+				change.Status = view.VariableSyncUpdate
+			case v.Value == d.Value && v.HCL == d.HCL:
+				change.Status = view.VariableSyncUnchanged
+			default:
+				change.Status = view.VariableSyncUpdate
+				change.PreviousValue = v.Value
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	prune := make(map[string]bool, len(pruneCategories))
+	for _, category := range pruneCategories {
+		prune[category] = true
+	}
+	for _, v := range existing {
+		if !prune[string(v.Category)] || wanted[string(v.Category)+"/"+v.Key] || v.Sensitive {
+			continue
+		}
+		changes = append(changes, view.VariableSyncChange{
+			Key:        v.Key,
+			Category:   string(v.Category),
+			Value:      v.Value,
+			HCL:        v.HCL,
+			VariableID: v.ID,
+			Status:     view.VariableSyncDelete,
+		})
+	}
+	return changes
+}
+
+// ApplyVariableSync creates, updates and deletes the planned variables one at a time and
+// returns the changes with their final status. A failed change has the error as its status and
+// does not stop the others.
+func ApplyVariableSync(c *client.TfxClient, workspaceID string, changes []view.VariableSyncChange) []view.VariableSyncChange {
+	output.Get().Logger().Debug("Applying variable sync", "workspaceID", workspaceID, "count", len(changes))
+
+	for i, change := range changes {
+		var err error
+		switch change.Status {
+		case view.VariableSyncCreate:
+			var variable *tfe.Variable
+			variable, err = CreateVariable(c, workspaceID, tfe.VariableCreateOptions{
+				Key:      tfe.String(change.Key),
+				Value:    tfe.String(change.Value),
+				Category: tfe.Category(tfe.CategoryType(change.Category)),
+				HCL:      tfe.Bool(change.HCL),
+			})
+			if err == nil {
+				changes[i].VariableID = variable.ID
+				changes[i].Status = view.VariableSyncCreated
+			}
+		case view.VariableSyncUpdate:
+			_, err = UpdateVariable(c, workspaceID, change.VariableID, tfe.VariableUpdateOptions{
+				Value: tfe.String(change.Value),
+				HCL:   tfe.Bool(change.HCL),
+			})
+			if err == nil {
+				changes[i].Status = view.VariableSyncUpdated
+			}
+		case view.VariableSyncDelete:
+			err = DeleteVariable(c, workspaceID, change.VariableID)
+			if err == nil {
+				changes[i].Status = view.VariableSyncDeleted
+			}
+		}
+		if err != nil {
+			changes[i].Status, changes[i].Error = view.VariableSyncFailed, err.Error()
+		}
+	}
+	return changes
+}
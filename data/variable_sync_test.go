@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/pkg/varfile"
+)
+
+func TestPlanVariableSync(t *testing.T) {
+	desired := []varfile.Variable{
+		{Key: "region", Value: "us-west-2", Category: varfile.CategoryTerraform},
+		{Key: "instance_count", Value: "3", Category: varfile.CategoryTerraform},
+		{Key: "azs", Value: `["a"]`, Category: varfile.CategoryTerraform, HCL: true},
+		{Key: "db_password", Value: "hunter2", Category: varfile.CategoryTerraform},
+		{Key: "region", Value: "us-west-2", Category: varfile.CategoryEnv},
+	}
+	existing := []*tfe.Variable{
+		{ID: "var-1", Key: "region", Value: "us-east-1", Category: tfe.CategoryTerraform},
+		{ID: "var-2", Key: "instance_count", Value: "3", Category: tfe.CategoryTerraform},
+		{ID: "var-3", Key: "db_password", Category: tfe.CategoryTerraform, Sensitive: true},
+		{ID: "var-4", Key: "old_tag", Value: "x", Category: tfe.CategoryTerraform},
+		{ID: "var-5", Key: "api_key", Category: tfe.CategoryTerraform, Sensitive: true},
+		{ID: "var-6", Key: "AWS_REGION", Value: "us-east-1", Category: tfe.CategoryEnv},
+	}
+
+	planned := []view.VariableSyncChange{
+		{Key: "region", Category: "terraform", Value: "us-west-2", PreviousValue: "us-east-1", VariableID: "var-1", Status: view.VariableSyncUpdate},
+		{Key: "instance_count", Category: "terraform", Value: "3", VariableID: "var-2", Status: view.VariableSyncUnchanged},
+		{Key: "azs", Category: "terraform", Value: `["a"]`, HCL: true, Status: view.VariableSyncCreate},
+		{Key: "db_password", Category: "terraform", Value: "hunter2", Sensitive: true, VariableID: "var-3", Status: view.VariableSyncUpdate},
+		{Key: "region", Category: "env", Value: "us-west-2", Status: view.VariableSyncCreate},
+	}
+	deleteTerraform := view.VariableSyncChange{Key: "old_tag", Category: "terraform", Value: "x", VariableID: "var-4", Status: view.VariableSyncDelete}
+	deleteEnv := view.VariableSyncChange{Key: "AWS_REGION", Category: "env", Value: "us-east-1", VariableID: "var-6", Status: view.VariableSyncDelete}
+
+	tests := map[string]struct {
+		pruneCategories []string
+		want            []view.VariableSyncChange
+	}{
+		"no prune":        {nil, planned},
+		"prune terraform": {[]string{varfile.CategoryTerraform}, append(append([]view.VariableSyncChange{}, planned...), deleteTerraform)},
+		"prune env":       {[]string{varfile.CategoryEnv}, append(append([]view.VariableSyncChange{}, planned...), deleteEnv)},
+		"prune both": {
+			[]string{varfile.CategoryTerraform, varfile.CategoryEnv},
+			append(append([]view.VariableSyncChange{}, planned...), deleteTerraform, deleteEnv),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := PlanVariableSync(desired, existing, tt.pruneCategories)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlanVariableSync() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// DefaultLockReason is used when locking workspaces without an explicit reason
+const DefaultLockReason = "Locked via TFx"
+
+// WorkspaceLockSnapshot records the lock state of workspaces before a bulk lock,
+// so that a later unlock only releases the locks tfx took
+type WorkspaceLockSnapshot struct {
+	Organization string                       `json:"organization"`
+	Reason       string                       `json:"reason"`
+	CreatedAt    time.Time                    `json:"createdAt"`
+	Workspaces   []WorkspaceLockSnapshotEntry `json:"workspaces"`
+}
+
+// WorkspaceLockSnapshotEntry is the recorded lock state of a single workspace.
+// LockedBy is the holder tfx locked the workspace as, or the holder of a lock that already existed.
+type WorkspaceLockSnapshotEntry struct {
+	Name        string                    `json:"name"`
+	ID          string                    `json:"id"`
+	LockedByTfx bool                      `json:"lockedByTfx"`
+	LockedBy    *view.WorkspaceLockHolder `json:"lockedBy,omitempty"`
+}
+
+// NewWorkspaceLockSnapshot records the lock state of workspaces before they are locked. Every
+// workspace that is not already locked is recorded as about to be locked by tfx, so the snapshot
+// can still release the locks if the bulk lock is interrupted before the results are written.
+func NewWorkspaceLockSnapshot(orgName string, workspaces []*tfe.Workspace, reason string) *WorkspaceLockSnapshot {
+	snapshot := &WorkspaceLockSnapshot{
+		Organization: orgName,
+		Reason:       reason,
+		CreatedAt:    time.Now().UTC(),
+	}
+	for _, w := range workspaces {
+		entry := WorkspaceLockSnapshotEntry{Name: w.Name, ID: w.ID, LockedByTfx: !w.Locked}
+		if w.Locked {
+			holder := view.LockHolder(w)
+			entry.LockedBy = &holder
+		}
+		snapshot.Workspaces = append(snapshot.Workspaces, entry)
+	}
+	return snapshot
+}
+
+// LockWorkspaces locks every workspace that is not already locked, concurrently, and returns the
+// result for each workspace along with a snapshot of which locks were taken
+func LockWorkspaces(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, reason string, parallelism int) ([]view.WorkspaceLockResult, *WorkspaceLockSnapshot) {
+	output.Get().Logger().Debug("Locking workspaces", "organization", orgName, "count", len(workspaces), "reason", reason)
+
+	type result struct {
+		lock  view.WorkspaceLockResult
+		entry WorkspaceLockSnapshotEntry
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		entry := WorkspaceLockSnapshotEntry{Name: w.Name, ID: w.ID}
+
+		if w.Locked {
+			holder := view.LockHolder(w)
+			entry.LockedBy = &holder
+			return result{
+				lock:  view.WorkspaceLockResult{Name: w.Name, Status: lockedByStatus(view.WorkspaceLockAlreadyLocked, holder)},
+				entry: entry,
+			}
+		}
+
+		locked, err := c.Client.Workspaces.Lock(c.Context, w.ID, tfe.WorkspaceLockOptions{Reason: tfe.String(reason)})
+		if err != nil {
+			output.Get().Logger().Error("Failed to lock workspace", "workspaceID", w.ID, "error", err)
+			return result{lock: view.WorkspaceLockResult{Name: w.Name, Status: view.WorkspaceLockFailed, Error: err.Error()}, entry: entry}
+		}
+
+		holder := view.LockHolder(locked)
+		entry.LockedByTfx = true
+		entry.LockedBy = &holder
+		return result{lock: view.WorkspaceLockResult{Name: w.Name, Status: view.WorkspaceLockLocked}, entry: entry}
+	})
+
+	snapshot := &WorkspaceLockSnapshot{
+		Organization: orgName,
+		Reason:       reason,
+		CreatedAt:    time.Now().UTC(),
+	}
+	locks := make([]view.WorkspaceLockResult, len(results))
+	for i, r := range results {
+		locks[i] = r.lock
+		snapshot.Workspaces = append(snapshot.Workspaces, r.entry)
+	}
+	return locks, snapshot
+}
+
+// RestoreWorkspaceLocks unlocks the workspaces that tfx locked in a snapshot. Workspaces that were
+// already locked are left alone, as are workspaces whose lock has since changed hands.
+// When only is non-nil, entries whose workspace ID is not in it are ignored.
+func RestoreWorkspaceLocks(c *client.TfxClient, snapshot *WorkspaceLockSnapshot, only map[string]bool, parallelism int) []view.WorkspaceLockResult {
+	output.Get().Logger().Debug("Restoring workspace locks", "organization", snapshot.Organization, "count", len(snapshot.Workspaces))
+
+	var entries []WorkspaceLockSnapshotEntry
+	for _, e := range snapshot.Workspaces {
+		if only == nil || only[e.ID] {
+			entries = append(entries, e)
+		}
+	}
+
+	return forEachConcurrent(entries, parallelism, func(e WorkspaceLockSnapshotEntry) view.WorkspaceLockResult {
+		if !e.LockedByTfx {
+			return view.WorkspaceLockResult{Name: e.Name, Status: view.WorkspaceLockSkippedNotOurs}
+		}
+
+		w, err := c.Client.Workspaces.ReadByIDWithOptions(c.Context, e.ID, &tfe.WorkspaceReadOptions{
+			Include: []tfe.WSIncludeOpt{tfe.WSLockedBy},
+		})
+		if err != nil {
+			output.Get().Logger().Error("Failed to read workspace", "workspaceID", e.ID, "error", err)
+			return view.WorkspaceLockResult{Name: e.Name, Status: view.WorkspaceLockFailed, Error: err.Error()}
+		}
+		if !w.Locked {
+			return view.WorkspaceLockResult{Name: e.Name, Status: view.WorkspaceLockAlreadyUnlocked}
+		}
+
+		holder := view.LockHolder(w)
+		if e.LockedBy != nil && e.LockedBy.ID != "" && holder.ID != e.LockedBy.ID {
+			return view.WorkspaceLockResult{Name: e.Name, Status: lockedByStatus(view.WorkspaceLockSkippedChanged, holder)}
+		}
+
+		if _, err := c.Client.Workspaces.Unlock(c.Context, w.ID); err != nil {
+			output.Get().Logger().Error("Failed to unlock workspace", "workspaceID", w.ID, "error", err)
+			return view.WorkspaceLockResult{Name: e.Name, Status: view.WorkspaceLockFailed, Error: err.Error()}
+		}
+		return view.WorkspaceLockResult{Name: e.Name, Status: view.WorkspaceLockUnlocked}
+	})
+}
+
+// WriteWorkspaceLockSnapshot writes a snapshot to path
+func WriteWorkspaceLockSnapshot(path string, snapshot *WorkspaceLockSnapshot) error {
+	b, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// ReadWorkspaceLockSnapshot reads a snapshot written by WriteWorkspaceLockSnapshot
+func ReadWorkspaceLockSnapshot(path string) (*WorkspaceLockSnapshot, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot WorkspaceLockSnapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, errors.Wrap(err, "failed to parse snapshot file")
+	}
+	return &snapshot, nil
+}
+
+func lockedByStatus(status string, holder view.WorkspaceLockHolder) string {
+	if holder.Type == "" {
+		return status
+	}
+	return fmt.Sprintf("%s by %s %s", status, holder.Type, holder.Name)
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// WorkspaceMoveFile is the on-disk format of a set of moves, used for rollback files
+type WorkspaceMoveFile struct {
+	Organization string               `json:"organization"`
+	CreatedAt    time.Time            `json:"createdAt"`
+	Moves        []view.WorkspaceMove `json:"moves"`
+}
+
+// PlanWorkspaceMoves builds the moves needed to place every workspace in the target project
+// and runs the preflight checks for each one
+func PlanWorkspaceMoves(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, target *tfe.Project) ([]view.WorkspaceMove, error) {
+	output.Get().Logger().Debug("Planning workspace moves", "organization", orgName, "count", len(workspaces), "targetProject", target.Name)
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := make([]view.WorkspaceMove, len(workspaces))
+	for i, w := range workspaces {
+		moves[i] = newWorkspaceMove(w, target.ID, target.Name, projectNames)
+	}
+	return moves, nil
+}
+
+// PlanWorkspaceMovesFromFile re-reads every workspace in a move file and plans moving it to the
+// project recorded in the file, checking that each target project still exists
+func PlanWorkspaceMovesFromFile(c *client.TfxClient, orgName string, file *WorkspaceMoveFile) ([]view.WorkspaceMove, error) {
+	output.Get().Logger().Debug("Planning workspace moves from file", "organization", orgName, "count", len(file.Moves))
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := make([]view.WorkspaceMove, len(file.Moves))
+	for i, m := range file.Moves {
+		w, err := c.Client.Workspaces.ReadByID(c.Context, m.WorkspaceID)
+		if err != nil {
+			output.Get().Logger().Error("Failed to read workspace", "workspaceID", m.WorkspaceID, "error", err)
+			return nil, errors.Wrapf(err, "failed to read workspace %s", m.WorkspaceName)
+		}
+
+		moves[i] = newWorkspaceMove(w, m.ToProjectID, m.ToProjectName, projectNames)
+		if _, ok := projectNames[m.ToProjectID]; !ok {
+			moves[i].Status = view.WorkspaceMoveMissingTarget
+		}
+	}
+	return moves, nil
+}
+
+// ApplyWorkspaceMoves moves every movable workspace concurrently and returns the updated moves
+func ApplyWorkspaceMoves(c *client.TfxClient, moves []view.WorkspaceMove, parallelism int) []view.WorkspaceMove {
+	output.Get().Logger().Debug("Applying workspace moves", "count", len(moves), "parallelism", parallelism)
+
+	return forEachConcurrent(moves, parallelism, func(m view.WorkspaceMove) view.WorkspaceMove {
+		if !m.Movable() {
+			return m
+		}
+
+		_, err := c.Client.Workspaces.UpdateByID(c.Context, m.WorkspaceID, tfe.WorkspaceUpdateOptions{
+			Project: &tfe.Project{ID: m.ToProjectID},
+		})
+		if err != nil {
+			output.Get().Logger().Error("Failed to move workspace", "workspaceID", m.WorkspaceID, "project", m.ToProjectID, "error", err)
+			m.Status = view.WorkspaceMoveFailed
+			m.Error = err.Error()
+			return m
+		}
+
+		output.Get().Logger().Debug("Workspace moved", "workspaceID", m.WorkspaceID, "project", m.ToProjectID)
+		m.Status = view.WorkspaceMoveMoved
+		return m
+	})
+}
+
+// WriteWorkspaceMoveRollback writes a move file that returns every movable workspace to its
+// original project
+func WriteWorkspaceMoveRollback(path string, orgName string, moves []view.WorkspaceMove) error {
+	file := WorkspaceMoveFile{
+		Organization: orgName,
+		CreatedAt:    time.Now().UTC(),
+	}
+	for _, m := range moves {
+		if !m.Movable() {
+			continue
+		}
+		file.Moves = append(file.Moves, view.WorkspaceMove{
+			WorkspaceName:   m.WorkspaceName,
+			WorkspaceID:     m.WorkspaceID,
+			FromProjectID:   m.ToProjectID,
+			FromProjectName: m.ToProjectName,
+			ToProjectID:     m.FromProjectID,
+			ToProjectName:   m.FromProjectName,
+		})
+	}
+
+	b, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// ReadWorkspaceMoveFile reads a move file written by WriteWorkspaceMoveRollback
+func ReadWorkspaceMoveFile(path string) (*WorkspaceMoveFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file WorkspaceMoveFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, errors.Wrap(err, "failed to parse move file")
+	}
+	return &file, nil
+}
+
+// newWorkspaceMove creates a move for a workspace and sets its preflight status
+func newWorkspaceMove(w *tfe.Workspace, toProjectID, toProjectName string, projectNames map[string]string) view.WorkspaceMove {
+	m := view.WorkspaceMove{
+		WorkspaceName: w.Name,
+		WorkspaceID:   w.ID,
+		ToProjectID:   toProjectID,
+		ToProjectName: toProjectName,
+		Status:        view.WorkspaceMovePending,
+	}
+	if w.Project != nil {
+		m.FromProjectID = w.Project.ID
+		m.FromProjectName = projectNames[w.Project.ID]
+	}
+
+	switch {
+	case m.FromProjectID == toProjectID:
+		m.Status = view.WorkspaceMoveAlreadyThere
+	case w.Permissions != nil && !w.Permissions.CanUpdate:
+		m.Status = view.WorkspaceMoveNoPermission
+	}
+	return m
+}
+
+// fetchProjectNames returns a map of project ID to project name for an organization
+func fetchProjectNames(c *client.TfxClient, orgName string) (map[string]string, error) {
+	projects, err := FetchProjects(c, orgName, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list projects")
+	}
+
+	names := make(map[string]string, len(projects))
+	for _, p := range projects {
+		names[p.ID] = p.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package data
+
+import (
+	"slices"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/pkg/errors"
+	"github.com/straubt1/tfx/client"
+	view "github.com/straubt1/tfx/cmd/views"
+	"github.com/straubt1/tfx/output"
+)
+
+// nonBillableResourceTypes are managed resources that do not count towards resources under
+// management (RUM)
+var nonBillableResourceTypes = []string{"null_resource", "terraform_data"}
+
+// ResourceFilter narrows the resources of a workspace by resource type and module path
+type ResourceFilter struct {
+	Types  []string
+	Module string
+}
+
+// Match returns true if the resource has one of the types and is in the module or one of its
+// child modules
+func (f ResourceFilter) Match(r *tfe.WorkspaceResource) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, r.ProviderType) {
+		return false
+	}
+	if f.Module != "" && r.Module != f.Module && !strings.HasPrefix(r.Module, f.Module+".") {
+		return false
+	}
+	return true
+}
+
+// FetchWorkspaceResources fetches all managed resources of a workspace
+func FetchWorkspaceResources(c *client.TfxClient, workspaceID string) ([]*tfe.WorkspaceResource, error) {
+	output.Get().Logger().Debug("Fetching workspace resources", "workspaceID", workspaceID)
+
+	return client.FetchAll(c.Context, func(pageNumber int) ([]*tfe.WorkspaceResource, *client.Pagination, error) {
+		output.Get().Logger().Trace("Fetching workspace resources page", "workspaceID", workspaceID, "page", pageNumber)
+
+		opts := &tfe.WorkspaceResourceListOptions{
+			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
+		}
+
+		result, err := c.Client.WorkspaceResources.List(c.Context, workspaceID, opts)
+		if err != nil {
+			output.Get().Logger().Error("Failed to fetch workspace resources page", "workspaceID", workspaceID, "page", pageNumber, "error", err)
+			return nil, nil, err
+		}
+
+		output.Get().Logger().Trace("Workspace resources page fetched", "workspaceID", workspaceID, "page", pageNumber, "count", len(result.Items))
+		return result.Items, client.NewPaginationFromTFE(result.Pagination), nil
+	})
+}
+
+// FetchWorkspaceResourceList fetches the resources of a workspace matching the filter along
+// with the run that last modified each one. Runs are found through the state versions that
+// modified the resources, read concurrently.
+func FetchWorkspaceResourceList(c *client.TfxClient, workspaceID string, filter ResourceFilter, parallelism int) ([]view.WorkspaceResource, error) {
+	resources, err := FetchWorkspaceResources(c, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*tfe.WorkspaceResource
+	var stateVersionIDs []string
+	for _, r := range resources {
+		if !filter.Match(r) {
+			continue
+		}
+		matched = append(matched, r)
+		if r.ModifiedByStateVersionID != "" && !slices.Contains(stateVersionIDs, r.ModifiedByStateVersionID) {
+			stateVersionIDs = append(stateVersionIDs, r.ModifiedByStateVersionID)
+		}
+	}
+
+	runIDs, err := fetchStateVersionRunIDs(c, stateVersionIDs, parallelism)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]view.WorkspaceResource, len(matched))
+	for i, r := range matched {
+		list[i] = view.WorkspaceResource{
+			Address:        r.Address,
+			Type:           r.ProviderType,
+			Provider:       r.Provider,
+			Module:         r.Module,
+			StateVersionID: r.ModifiedByStateVersionID,
+			RunID:          runIDs[r.ModifiedByStateVersionID],
+			UpdatedAt:      r.UpdatedAt,
+		}
+	}
+	return list, nil
+}
+
+// fetchStateVersionRunIDs maps each state version to the run that created it. State versions
+// uploaded outside of a run have no run and are left out.
+func fetchStateVersionRunIDs(c *client.TfxClient, stateVersionIDs []string, parallelism int) (map[string]string, error) {
+	type result struct {
+		runID string
+		err   error
+	}
+	results := forEachConcurrent(stateVersionIDs, parallelism, func(id string) result {
+		sv, err := FetchStateVersion(c, id)
+		if err != nil {
+			return result{err: errors.Wrapf(err, "failed to read state version %s", id)}
+		}
+		if sv.Run == nil {
+			return result{}
+		}
+		return result{runID: sv.Run.ID}
+	})
+
+	runIDs := make(map[string]string, len(stateVersionIDs))
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.runID != "" {
+			runIDs[stateVersionIDs[i]] = r.runID
+		}
+	}
+	return runIDs, nil
+}
+
+// FetchResourceCounts counts the resources of every workspace. The count reported by the
+// workspace is used unless billable is true, in which case the resources of each workspace are
+// listed concurrently so resource types that do not count towards RUM can be left out.
+func FetchResourceCounts(c *client.TfxClient, orgName string, workspaces []*tfe.Workspace, billable bool, parallelism int) ([]view.WorkspaceResourceCount, error) {
+	output.Get().Logger().Debug("Counting workspace resources", "organization", orgName, "count", len(workspaces), "billable", billable)
+
+	projectNames, err := fetchProjectNames(c, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]view.WorkspaceResourceCount, len(workspaces))
+	for i, w := range workspaces {
+		counts[i] = view.WorkspaceResourceCount{
+			WorkspaceName: w.Name,
+			WorkspaceID:   w.ID,
+			Resources:     w.ResourceCount,
+		}
+		if w.Project != nil {
+			counts[i].ProjectName = projectNames[w.Project.ID]
+		}
+	}
+	if !billable {
+		return counts, nil
+	}
+
+	type result struct {
+		billable int
+		err      error
+	}
+	results := forEachConcurrent(workspaces, parallelism, func(w *tfe.Workspace) result {
+		resources, err := FetchWorkspaceResources(c, w.ID)
+		if err != nil {
+			return result{err: errors.Wrapf(err, "failed to list resources for %s", w.Name)}
+		}
+		n := 0
+		for _, r := range resources {
+			if !slices.Contains(nonBillableResourceTypes, r.ProviderType) {
+				n++
+			}
+		}
+		return result{billable: n}
+	})
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		billable := r.billable
+		counts[i].Billable = &billable
+	}
+	return counts, nil
+}
@@ -4,6 +4,7 @@
 package data
 
 import (
+	"fmt"
 	"math"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -23,7 +24,7 @@ func FetchWorkspaces(c *client.TfxClient, orgName string, options *flags.Workspa
 
 		opts := &tfe.WorkspaceListOptions{
 			ListOptions: tfe.ListOptions{PageNumber: pageNumber, PageSize: 100},
-			Include:     []tfe.WSIncludeOpt{"organization", "current_run"},
+			Include:     []tfe.WSIncludeOpt{"organization", "current_run", tfe.WSLockedBy},
 		}
 
 		// Apply search and filter options if provided
@@ -106,6 +107,82 @@ func FetchWorkspacesAcrossOrgs(c *client.TfxClient, options *flags.WorkspaceList
 	return FetchWorkspacesWithOrgScope(c, "", &optionsWithAll)
 }
 
+// WorkspaceSelector selects a set of workspaces for bulk operations
+type WorkspaceSelector struct {
+	Names        []string
+	Search       string
+	WildcardName string
+	Tags         string
+	ExcludeTags  string
+	ProjectName  string
+}
+
+// IsEmpty returns true if no selection criteria are set
+func (s WorkspaceSelector) IsEmpty() bool {
+	return len(s.Names) == 0 && s.Search == "" && s.WildcardName == "" &&
+		s.Tags == "" && s.ExcludeTags == "" && s.ProjectName == ""
+}
+
+// hasFilters returns true if any list filter (anything other than explicit names) is set
+func (s WorkspaceSelector) hasFilters() bool {
+	return s.Search != "" || s.WildcardName != "" || s.Tags != "" || s.ExcludeTags != "" || s.ProjectName != ""
+}
+
+// FetchWorkspacesBySelector resolves a selector into workspaces.
+// Filters are passed through to FetchWorkspaces. When names are given without filters each
+// workspace is read directly, when combined with filters only matching names are kept.
+func FetchWorkspacesBySelector(c *client.TfxClient, orgName string, sel WorkspaceSelector) ([]*tfe.Workspace, error) {
+	output.Get().Logger().Debug("Fetching workspaces by selector", "organization", orgName, "selector", sel)
+
+	if len(sel.Names) > 0 && !sel.hasFilters() {
+		var workspaces []*tfe.Workspace
+		for _, name := range sel.Names {
+			w, err := FetchWorkspaceWithLock(c, orgName, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read workspace %s", name)
+			}
+			workspaces = append(workspaces, w)
+		}
+		return workspaces, nil
+	}
+
+	opts := &flags.WorkspaceListFlags{
+		Search:       sel.Search,
+		WildcardName: sel.WildcardName,
+		Tags:         sel.Tags,
+		ExcludeTags:  sel.ExcludeTags,
+	}
+	if sel.ProjectName != "" {
+		project, err := FetchProjectByName(c, orgName, sel.ProjectName)
+		if err != nil {
+			return nil, err
+		}
+		opts.ProjectID = project.ID
+	}
+
+	workspaces, err := FetchWorkspaces(c, orgName, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sel.Names) == 0 {
+		return workspaces, nil
+	}
+
+	names := make(map[string]bool, len(sel.Names))
+	for _, n := range sel.Names {
+		names[n] = true
+	}
+	var filtered []*tfe.Workspace
+	for _, w := range workspaces {
+		if names[w.Name] {
+			filtered = append(filtered, w)
+		}
+	}
+
+	output.Get().Logger().Debug("Workspaces filtered by name", "organization", orgName, "count", len(filtered))
+	return filtered, nil
+}
+
 // FetchWorkspace fetches a single workspace by name in the specified organization
 func FetchWorkspace(c *client.TfxClient, orgName string, workspaceName string) (*tfe.Workspace, error) {
 	output.Get().Logger().Debug("Fetching workspace by name", "organization", orgName, "workspaceName", workspaceName)
@@ -235,6 +312,79 @@ func GetTeamAccessNames(c *client.TfxClient, teamAccess []*tfe.TeamAccess) ([]in
 	return teamNames, nil
 }
 
+// FetchLockedWorkspaces fetches the workspaces matching options that are currently locked
+func FetchLockedWorkspaces(c *client.TfxClient, orgName string, options *flags.WorkspaceListFlags) ([]*tfe.Workspace, error) {
+	workspaces, err := FetchWorkspaces(c, orgName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked []*tfe.Workspace
+	for _, w := range workspaces {
+		if w.Locked {
+			locked = append(locked, w)
+		}
+	}
+
+	output.Get().Logger().Debug("Locked workspaces fetched", "organization", orgName, "total", len(workspaces), "locked", len(locked))
+	return locked, nil
+}
+
+// FetchWorkspaceWithLock fetches a single workspace by name, including the user, run or team holding its lock
+func FetchWorkspaceWithLock(c *client.TfxClient, orgName string, workspaceName string) (*tfe.Workspace, error) {
+	output.Get().Logger().Debug("Fetching workspace lock", "organization", orgName, "workspaceName", workspaceName)
+
+	workspace, err := c.Client.Workspaces.ReadWithOptions(c.Context, orgName, workspaceName, &tfe.WorkspaceReadOptions{
+		Include: []tfe.WSIncludeOpt{tfe.WSLockedBy},
+	})
+	if err != nil {
+		output.Get().Logger().Error("Failed to fetch workspace lock", "organization", orgName, "workspaceName", workspaceName, "error", err)
+		return nil, err
+	}
+	return workspace, nil
+}
+
+// workspaceLockReason is the subset of the workspace API response that carries the lock reason,
+// which go-tfe does not expose
+type workspaceLockReason struct {
+	Data struct {
+		Attributes struct {
+			LockedReason string `json:"locked-reason"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// FetchWorkspaceLockReason reads the reason recorded when the workspace was locked
+func FetchWorkspaceLockReason(c *client.TfxClient, workspaceID string) (string, error) {
+	var resp workspaceLockReason
+	if err := fetchAPIJSON(c, fmt.Sprintf("/api/v2/workspaces/%s", workspaceID), &resp); err != nil {
+		output.Get().Logger().Error("Failed to read workspace lock reason", "workspaceID", workspaceID, "error", err)
+		return "", err
+	}
+	return resp.Data.Attributes.LockedReason, nil
+}
+
+// FetchWorkspaceLockReasons reads the lock reason of each workspace concurrently, keyed by workspace ID
+func FetchWorkspaceLockReasons(c *client.TfxClient, workspaces []*tfe.Workspace) (map[string]string, error) {
+	type result struct {
+		reason string
+		err    error
+	}
+	results := forEachConcurrent(workspaces, DefaultParallelism, func(w *tfe.Workspace) result {
+		reason, err := FetchWorkspaceLockReason(c, w.ID)
+		return result{reason: reason, err: err}
+	})
+
+	reasons := make(map[string]string, len(workspaces))
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		reasons[workspaces[i].ID] = r.reason
+	}
+	return reasons, nil
+}
+
 // SetWorkspaceLock locks or unlocks the workspace by name
 func SetWorkspaceLock(c *client.TfxClient, orgName, workspaceName string, lockSet bool) (string, error) {
 	output.Get().Logger().Debug("Setting workspace lock", "organization", orgName, "workspaceName", workspaceName, "lock", lockSet)
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package costestimate reads the resource breakdown of a cost estimate from its log and
+// works with the monthly cost amounts the API reports as decimal strings.
+package costestimate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Resource is the estimated monthly cost of a single resource. Unmatched resources are
+// resources the estimate has no pricing for, their costs are always zero.
+type Resource struct {
+	Address             string  `json:"address"`
+	Type                string  `json:"type"`
+	Matched             bool    `json:"matched"`
+	PriorMonthlyCost    float64 `json:"priorMonthlyCost"`
+	ProposedMonthlyCost float64 `json:"proposedMonthlyCost"`
+	DeltaMonthlyCost    float64 `json:"deltaMonthlyCost"`
+}
+
+// estimateLog is the JSON document written as the log of a cost estimate
+type estimateLog struct {
+	Resources struct {
+		Matched   []logResource `json:"Matched"`
+		Unmatched []logResource `json:"Unmatched"`
+	} `json:"Resources"`
+}
+
+type logResource struct {
+	Address             string `json:"Address"`
+	Type                string `json:"Type"`
+	Name                string `json:"Name"`
+	PriorMonthlyCost    amount `json:"PriorMonthlyCost"`
+	ProposedMonthlyCost amount `json:"ProposedMonthlyCost"`
+	DeltaMonthlyCost    amount `json:"DeltaMonthlyCost"`
+}
+
+// amount is a cost that may be written as a number or a decimal string
+type amount float64
+
+func (a *amount) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*a = 0
+		return nil
+	}
+	f, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	*a = amount(f)
+	return nil
+}
+
+// ParseResources returns the resources in a cost estimate log, most expensive change first.
+// Logs that are not a JSON breakdown, such as those of older Terraform Enterprise versions,
+// have no resources.
+func ParseResources(log []byte) ([]Resource, error) {
+	log = bytes.TrimSpace(log)
+	if len(log) == 0 || log[0] != '{' {
+		return []Resource{}, nil
+	}
+
+	var l estimateLog
+	if err := json.Unmarshal(log, &l); err != nil {
+		return nil, fmt.Errorf("failed to parse cost estimate log: %w", err)
+	}
+
+	resources := make([]Resource, 0, len(l.Resources.Matched)+len(l.Resources.Unmatched))
+	add := func(r logResource, matched bool) {
+		address := r.Address
+		if address == "" && r.Type != "" && r.Name != "" {
+			address = r.Type + "." + r.Name
+		}
+		delta := float64(r.DeltaMonthlyCost)
+		if delta == 0 {
+			delta = float64(r.ProposedMonthlyCost - r.PriorMonthlyCost)
+		}
+		resources = append(resources, Resource{
+			Address:             address,
+			Type:                r.Type,
+			Matched:             matched,
+			PriorMonthlyCost:    float64(r.PriorMonthlyCost),
+			ProposedMonthlyCost: float64(r.ProposedMonthlyCost),
+			DeltaMonthlyCost:    delta,
+		})
+	}
+	for _, r := range l.Resources.Matched {
+		add(r, true)
+	}
+	for _, r := range l.Resources.Unmatched {
+		add(r, false)
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		di, dj := math.Abs(resources[i].DeltaMonthlyCost), math.Abs(resources[j].DeltaMonthlyCost)
+		if di != dj {
+			return di > dj
+		}
+		return resources[i].Address < resources[j].Address
+	})
+	return resources, nil
+}
+
+// ParseAmount parses a cost amount such as "12.34", an empty amount is zero
+func ParseAmount(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cost amount '%s'", s)
+	}
+	return f, nil
+}
+
+// FormatAmount formats a monthly cost in dollars, i.e. $1,234.50
+func FormatAmount(f float64) string {
+	sign := ""
+	if f < 0 {
+		sign = "-"
+	}
+	return sign + "$" + groupThousands(math.Abs(f))
+}
+
+// FormatDelta formats a change in monthly cost with its sign, i.e. +$12.00
+func FormatDelta(f float64) string {
+	if f < 0 {
+		return FormatAmount(f)
+	}
+	return "+" + FormatAmount(f)
+}
+
+func groupThousands(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	whole, cents := s[:len(s)-3], s[len(s)-3:]
+	var sb strings.Builder
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(d)
+	}
+	return sb.String() + cents
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package costestimate
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testEstimateLog = `{
+  "Resources": {
+    "Matched": [
+      {"Address": "aws_instance.web", "Type": "aws_instance", "Name": "web",
+       "PriorMonthlyCost": "0.0", "ProposedMonthlyCost": "8.468", "DeltaMonthlyCost": "8.468"},
+      {"Address": "aws_db_instance.main", "Type": "aws_db_instance", "Name": "main",
+       "PriorMonthlyCost": "124.1", "ProposedMonthlyCost": "248.2", "DeltaMonthlyCost": "124.1"},
+      {"Type": "aws_ebs_volume", "Name": "data", "PriorMonthlyCost": 10, "ProposedMonthlyCost": 10}
+    ],
+    "Unmatched": [
+      {"Address": "random_pet.name", "Type": "random_pet", "Name": "name"}
+    ]
+  }
+}`
+
+func TestParseResources(t *testing.T) {
+	got, err := ParseResources([]byte(testEstimateLog))
+	if err != nil {
+		t.Fatalf("ParseResources() error = %v", err)
+	}
+
+	var addresses []string
+	for _, r := range got {
+		addresses = append(addresses, r.Address)
+	}
+	want := []string{"aws_db_instance.main", "aws_instance.web", "aws_ebs_volume.data", "random_pet.name"}
+	if !reflect.DeepEqual(addresses, want) {
+		t.Errorf("addresses = %v, want %v", addresses, want)
+	}
+	if got[0].DeltaMonthlyCost != 124.1 || got[0].ProposedMonthlyCost != 248.2 {
+		t.Errorf("aws_db_instance.main = %+v, want delta 124.1 and proposed 248.2", got[0])
+	}
+	if got[3].Matched {
+		t.Errorf("random_pet.name should not be matched")
+	}
+
+	empty, err := ParseResources([]byte("Cost estimation finished"))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("ParseResources(text) = %v, %v, want no resources", empty, err)
+	}
+	if _, err := ParseResources([]byte(`{"Resources": {"Matched": [{"PriorMonthlyCost": "abc"}]}}`)); err == nil {
+		t.Error("ParseResources() expected error for an invalid amount")
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := map[float64]string{
+		0:         "$0.00",
+		8.468:     "$8.47",
+		1234.5:    "$1,234.50",
+		-1234567:  "-$1,234,567.00",
+		999999.99: "$999,999.99",
+	}
+	for f, want := range tests {
+		if got := FormatAmount(f); got != want {
+			t.Errorf("FormatAmount(%v) = %q, want %q", f, got, want)
+		}
+	}
+	if got := FormatDelta(12); got != "+$12.00" {
+		t.Errorf("FormatDelta(12) = %q, want +$12.00", got)
+	}
+	if got := FormatDelta(-3.5); got != "-$3.50" {
+		t.Errorf("FormatDelta(-3.5) = %q, want -$3.50", got)
+	}
+}
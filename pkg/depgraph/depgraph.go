@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package depgraph models dependencies between workspaces and renders them as
+// Graphviz DOT, Mermaid or JSON. An edge always points from the workspace that
+// changes to the workspace it affects.
+package depgraph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EdgeKind is the type of dependency between two workspaces
+type EdgeKind string
+
+const (
+	// EdgeRunTrigger is a run trigger, applies in From queue runs in To
+	EdgeRunTrigger EdgeKind = "run-trigger"
+	// EdgeRemoteState is remote state sharing, To reads the state of From
+	EdgeRemoteState EdgeKind = "remote-state"
+)
+
+// Node is a workspace in the graph
+type Node struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	GlobalRemoteState bool   `json:"globalRemoteState"`
+}
+
+// Edge is a dependency between two workspaces, identified by their IDs
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+}
+
+// Graph is a set of workspaces and the dependencies between them
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+
+	nodes map[string]int
+	edges map[Edge]bool
+}
+
+// New creates an empty graph
+func New() *Graph {
+	return &Graph{
+		Nodes: []Node{},
+		Edges: []Edge{},
+		nodes: map[string]int{},
+		edges: map[Edge]bool{},
+	}
+}
+
+// AddNode adds a node. Adding a node that already exists fills in its name and
+// keeps the global remote state flag if either copy has it set.
+func (g *Graph) AddNode(n Node) {
+	if i, ok := g.nodes[n.ID]; ok {
+		if g.Nodes[i].Name == "" {
+			g.Nodes[i].Name = n.Name
+		}
+		g.Nodes[i].GlobalRemoteState = g.Nodes[i].GlobalRemoteState || n.GlobalRemoteState
+		return
+	}
+	g.nodes[n.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, n)
+}
+
+// AddEdge adds an edge, ignoring duplicates. Nodes must be added separately.
+func (g *Graph) AddEdge(e Edge) {
+	if g.edges[e] {
+		return
+	}
+	g.edges[e] = true
+	g.Edges = append(g.Edges, e)
+}
+
+// Node returns the node with the given ID
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.nodes[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Downstream returns the subgraph of every node reachable from id, including id itself.
+// This is the blast radius of a change to that workspace.
+func (g *Graph) Downstream(id string) *Graph {
+	out := map[string][]Edge{}
+	for _, e := range g.Edges {
+		out[e.From] = append(out[e.From], e)
+	}
+
+	sub := New()
+	n, ok := g.Node(id)
+	if !ok {
+		return sub
+	}
+	sub.AddNode(n)
+
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, e := range out[current] {
+			if _, seen := sub.nodes[e.To]; !seen {
+				to, _ := g.Node(e.To)
+				sub.AddNode(to)
+				queue = append(queue, e.To)
+			}
+			sub.AddEdge(e)
+		}
+	}
+	return sub.Sorted()
+}
+
+// Sorted returns a copy of the graph with nodes ordered by name and edges by node names,
+// so rendered output is stable between runs
+func (g *Graph) Sorted() *Graph {
+	nodes := append([]Node{}, g.Nodes...)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Name != nodes[j].Name {
+			return nodes[i].Name < nodes[j].Name
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	sorted := New()
+	for _, n := range nodes {
+		sorted.AddNode(n)
+	}
+
+	edges := append([]Edge{}, g.Edges...)
+	name := func(id string) string {
+		n, _ := g.Node(id)
+		return n.Name
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if name(a.From) != name(b.From) {
+			return name(a.From) < name(b.From)
+		}
+		if name(a.To) != name(b.To) {
+			return name(a.To) < name(b.To)
+		}
+		return a.Kind < b.Kind
+	})
+	for _, e := range edges {
+		sorted.AddEdge(e)
+	}
+	return sorted
+}
+
+// DOT renders the graph in Graphviz DOT format
+func (g *Graph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph tfx {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+
+	for _, n := range g.Nodes {
+		if n.GlobalRemoteState {
+			fmt.Fprintf(&sb, "  %s [label=%s, style=filled, fillcolor=\"#fde2e1\"];\n", dotQuote(n.ID), dotQuote(n.Name+"\n(global remote state)"))
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s [label=%s];\n", dotQuote(n.ID), dotQuote(n.Name))
+	}
+	for _, e := range g.Edges {
+		attrs := "label=\"run trigger\""
+		if e.Kind == EdgeRemoteState {
+			attrs = "label=\"remote state\", style=dashed"
+		}
+		fmt.Fprintf(&sb, "  %s -> %s [%s];\n", dotQuote(e.From), dotQuote(e.To), attrs)
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart
+func (g *Graph) Mermaid() string {
+	ids := make(map[string]string, len(g.Nodes))
+	for i, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+
+	global := false
+	for _, n := range g.Nodes {
+		if n.GlobalRemoteState {
+			global = true
+			fmt.Fprintf(&sb, "  %s[\"%s<br/>(global remote state)\"]:::global\n", ids[n.ID], mermaidEscape(n.Name))
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s[\"%s\"]\n", ids[n.ID], mermaidEscape(n.Name))
+	}
+	for _, e := range g.Edges {
+		if e.Kind == EdgeRemoteState {
+			fmt.Fprintf(&sb, "  %s -.->|remote state| %s\n", ids[e.From], ids[e.To])
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s -->|run trigger| %s\n", ids[e.From], ids[e.To])
+	}
+	if global {
+		sb.WriteString("  classDef global fill:#fde2e1,stroke:#c0392b\n")
+	}
+	return sb.String()
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
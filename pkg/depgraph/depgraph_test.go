@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package depgraph
+
+import (
+	"testing"
+)
+
+func testGraph() *Graph {
+	g := New()
+	g.AddNode(Node{ID: "ws-net", Name: "network", GlobalRemoteState: true})
+	g.AddNode(Node{ID: "ws-app", Name: "app"})
+	g.AddNode(Node{ID: "ws-db", Name: "db"})
+	g.AddNode(Node{ID: "ws-dns", Name: "dns"})
+	g.AddEdge(Edge{From: "ws-net", To: "ws-app", Kind: EdgeRunTrigger})
+	g.AddEdge(Edge{From: "ws-net", To: "ws-app", Kind: EdgeRunTrigger})
+	g.AddEdge(Edge{From: "ws-db", To: "ws-app", Kind: EdgeRemoteState})
+	g.AddEdge(Edge{From: "ws-dns", To: "ws-net", Kind: EdgeRemoteState})
+	return g
+}
+
+func TestAddNodeMerges(t *testing.T) {
+	g := New()
+	g.AddNode(Node{ID: "ws-1"})
+	g.AddNode(Node{ID: "ws-1", Name: "app", GlobalRemoteState: true})
+
+	if len(g.Nodes) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(g.Nodes))
+	}
+	if n := g.Nodes[0]; n.Name != "app" || !n.GlobalRemoteState {
+		t.Errorf("node not merged: %+v", n)
+	}
+}
+
+func TestDownstream(t *testing.T) {
+	sub := testGraph().Downstream("ws-dns")
+
+	var names []string
+	for _, n := range sub.Nodes {
+		names = append(names, n.Name)
+	}
+	want := []string{"app", "dns", "network"}
+	if len(names) != len(want) {
+		t.Fatalf("Downstream() nodes = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("Downstream() nodes = %v, want %v", names, want)
+		}
+	}
+	if len(sub.Edges) != 2 {
+		t.Errorf("Downstream() edges = %d, want 2", len(sub.Edges))
+	}
+
+	if missing := testGraph().Downstream("ws-none"); len(missing.Nodes) != 0 {
+		t.Errorf("Downstream() of unknown node returned %d nodes", len(missing.Nodes))
+	}
+}
+
+func TestDOT(t *testing.T) {
+	got := testGraph().Downstream("ws-net").DOT()
+	want := `digraph tfx {
+  rankdir=LR;
+  node [shape=box];
+  "ws-app" [label="app"];
+  "ws-net" [label="network\n(global remote state)", style=filled, fillcolor="#fde2e1"];
+  "ws-net" -> "ws-app" [label="run trigger"];
+}
+`
+	if got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMermaid(t *testing.T) {
+	got := testGraph().Sorted().Mermaid()
+	want := `flowchart LR
+  n0["app"]
+  n1["db"]
+  n2["dns"]
+  n3["network<br/>(global remote state)"]:::global
+  n1 -.->|remote state| n0
+  n2 -.->|remote state| n3
+  n3 -->|run trigger| n0
+  classDef global fill:#fde2e1,stroke:#c0392b
+`
+	if got != want {
+		t.Errorf("Mermaid() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package file
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BundleEntry is a file written to a bundle, Name is a slash separated path within the bundle
+type BundleEntry struct {
+	Name    string
+	Content []byte
+}
+
+// IsTarball returns true if the path names a gzipped tarball
+func IsTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// WriteBundle writes entries to a gzipped tarball when path ends in .tar.gz or .tgz,
+// otherwise into the directory at path, creating it if needed. Returns the absolute path written.
+func WriteBundle(path string, entries []BundleEntry) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		if !filepath.IsLocal(filepath.FromSlash(e.Name)) {
+			return "", fmt.Errorf("bundle entry %q is not a relative path", e.Name)
+		}
+	}
+
+	if IsTarball(path) {
+		return path, writeTarball(path, entries)
+	}
+
+	for _, e := range entries {
+		target := filepath.Join(path, filepath.FromSlash(e.Name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(target, e.Content, 0644); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// writeTarball writes entries to a gzipped tarball at path
+func writeTarball(path string, entries []BundleEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name:    e.Name,
+			Mode:    0644,
+			Size:    int64(len(e.Content)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(e.Content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
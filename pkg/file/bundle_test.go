@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package file
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testEntries = []BundleEntry{
+	{Name: "run.json", Content: []byte(`{"data":{}}`)},
+	{Name: "policy-checks/polchk-1.log", Content: []byte("passed\n")},
+}
+
+func TestWriteBundleDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "run-1")
+
+	got, err := WriteBundle(dir, testEntries)
+	if err != nil {
+		t.Fatalf("WriteBundle() error = %v", err)
+	}
+	if got != dir {
+		t.Errorf("WriteBundle() = %q, want %q", got, dir)
+	}
+	for _, e := range testEntries {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(e.Name)))
+		if err != nil {
+			t.Fatalf("reading %s: %v", e.Name, err)
+		}
+		if string(b) != string(e.Content) {
+			t.Errorf("%s = %q, want %q", e.Name, b, e.Content)
+		}
+	}
+}
+
+func TestWriteBundleTarball(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run-1.tar.gz")
+
+	if _, err := WriteBundle(path, testEntries); err != nil {
+		t.Fatalf("WriteBundle() error = %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	got := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(tr)
+		got[hdr.Name] = string(b)
+	}
+	for _, e := range testEntries {
+		if got[e.Name] != string(e.Content) {
+			t.Errorf("%s = %q, want %q", e.Name, got[e.Name], e.Content)
+		}
+	}
+}
+
+func TestWriteBundleRejectsEscapingNames(t *testing.T) {
+	_, err := WriteBundle(t.TempDir(), []BundleEntry{{Name: "../outside.log"}})
+	if err == nil {
+		t.Error("WriteBundle() with ../outside.log = nil error, want error")
+	}
+}
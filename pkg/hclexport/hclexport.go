@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package hclexport builds Terraform configuration files on top of hclwrite.
+// It only supports what tfx needs to emit resource, import and variable blocks.
+package hclexport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Expr is a raw HCL expression written without quoting, such as a reference
+// to another resource (tfe_project.default.id).
+type Expr string
+
+// Block is an HCL block with attributes and nested blocks
+type Block struct {
+	Type   string
+	Labels []string
+
+	attrs  []attribute
+	blocks []*Block
+}
+
+type attribute struct {
+	name  string
+	value interface{}
+}
+
+// NewBlock creates a block with the given type and labels
+func NewBlock(blockType string, labels ...string) *Block {
+	return &Block{Type: blockType, Labels: labels}
+}
+
+// Set adds an attribute to the block. Supported values are string, bool, int,
+// []string, Expr and map[string]interface{}. A nil value is ignored.
+func (b *Block) Set(name string, value interface{}) *Block {
+	if value == nil {
+		return b
+	}
+	b.attrs = append(b.attrs, attribute{name: name, value: value})
+	return b
+}
+
+// SetIf adds an attribute only when cond is true
+func (b *Block) SetIf(cond bool, name string, value interface{}) *Block {
+	if cond {
+		b.Set(name, value)
+	}
+	return b
+}
+
+// AddBlock appends a nested block and returns it
+func (b *Block) AddBlock(blockType string, labels ...string) *Block {
+	nested := NewBlock(blockType, labels...)
+	b.blocks = append(b.blocks, nested)
+	return nested
+}
+
+// Append adds an existing block as a nested block
+func (b *Block) Append(nested *Block) *Block {
+	b.blocks = append(b.blocks, nested)
+	return b
+}
+
+// HasAttributes returns true if any attribute has been set
+func (b *Block) HasAttributes() bool {
+	return len(b.attrs) > 0
+}
+
+// build converts the block into an hclwrite block. Attributes are written before nested
+// blocks, which are separated by a blank line.
+func (b *Block) build() *hclwrite.Block {
+	block := hclwrite.NewBlock(b.Type, b.Labels)
+	body := block.Body()
+	for _, a := range b.attrs {
+		body.SetAttributeRaw(a.name, valueTokens(a.value))
+	}
+	for i, nested := range b.blocks {
+		if i > 0 || len(b.attrs) > 0 {
+			body.AppendNewline()
+		}
+		body.AppendBlock(nested.build())
+	}
+	return block
+}
+
+// valueTokens converts an attribute value into HCL tokens, leaving string escaping to hclwrite
+func valueTokens(value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case Expr:
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(v)}}
+	case string:
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case []string:
+		items := make([]hclwrite.Tokens, len(v))
+		for i, s := range v {
+			items[i] = hclwrite.TokensForValue(cty.StringVal(s))
+		}
+		return hclwrite.TokensForTuple(items)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		attrs := make([]hclwrite.ObjectAttrTokens, len(keys))
+		for i, k := range keys {
+			attrs[i] = hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(k),
+				Value: valueTokens(v[k]),
+			}
+		}
+		return hclwrite.TokensForObject(attrs)
+	default:
+		return hclwrite.TokensForValue(cty.StringVal(fmt.Sprint(v)))
+	}
+}
+
+// Identifier converts s into a valid, lower case HCL identifier
+func Identifier(s string) string {
+	var sb strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(s) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sb.WriteRune(r)
+			lastUnderscore = false
+			continue
+		}
+		if !lastUnderscore {
+			sb.WriteRune('_')
+			lastUnderscore = true
+		}
+	}
+
+	id := strings.Trim(sb.String(), "_")
+	if id == "" {
+		return "unnamed"
+	}
+	if unicode.IsDigit(rune(id[0])) {
+		id = "_" + id
+	}
+	return id
+}
+
+// Names hands out unique identifiers per namespace (usually a resource type)
+type Names struct {
+	used map[string]map[string]bool
+}
+
+// NewNames creates an empty set of names
+func NewNames() *Names {
+	return &Names{used: map[string]map[string]bool{}}
+}
+
+// Unique returns Identifier(base), suffixed with a counter if it was already
+// handed out for the given namespace.
+func (n *Names) Unique(namespace, base string) string {
+	if n.used[namespace] == nil {
+		n.used[namespace] = map[string]bool{}
+	}
+
+	id := Identifier(base)
+	candidate := id
+	for i := 2; n.used[namespace][candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", id, i)
+	}
+	n.used[namespace][candidate] = true
+	return candidate
+}
+
+// File is a named Terraform configuration file
+type File struct {
+	Name   string
+	blocks []*Block
+}
+
+// NewFile creates an empty file with the given name
+func NewFile(name string) *File {
+	return &File{Name: name}
+}
+
+// Add appends top level blocks to the file
+func (f *File) Add(blocks ...*Block) {
+	f.blocks = append(f.blocks, blocks...)
+}
+
+// Blocks returns the top level blocks of the file
+func (f *File) Blocks() []*Block {
+	return f.blocks
+}
+
+// Count returns the number of top level blocks with the given type
+func (f *File) Count(blockType string) int {
+	count := 0
+	for _, b := range f.blocks {
+		if b.Type == blockType {
+			count++
+		}
+	}
+	return count
+}
+
+// Bytes renders the file contents, formatted the way `terraform fmt` does
+func (f *File) Bytes() []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, b := range f.blocks {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		body.AppendBlock(b.build())
+	}
+	return hclwrite.Format(file.Bytes())
+}
+
+// WriteFiles writes every file into directory, creating it if needed, and
+// returns the paths that were written.
+func WriteFiles(directory string, files []*File) ([]string, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		path := filepath.Join(directory, f.Name)
+		if err := os.WriteFile(path, f.Bytes(), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
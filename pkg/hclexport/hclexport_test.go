@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package hclexport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Default Project", want: "default_project"},
+		{in: "app-dev--us.west", want: "app_dev_us_west"},
+		{in: "1st-workspace", want: "_1st_workspace"},
+		{in: "__", want: "unnamed"},
+		{in: "café", want: "caf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Identifier(tt.in); got != tt.want {
+				t.Errorf("Identifier(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNames_Unique(t *testing.T) {
+	n := NewNames()
+	got := []string{
+		n.Unique("tfe_workspace", "app-dev"),
+		n.Unique("tfe_workspace", "app_dev"),
+		n.Unique("tfe_workspace", "app dev"),
+		n.Unique("tfe_project", "app-dev"),
+	}
+	want := []string{"app_dev", "app_dev_2", "app_dev_3", "app_dev"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Unique() #%d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFile_Bytes(t *testing.T) {
+	f := NewFile("main.tf")
+
+	resource := NewBlock("resource", "tfe_workspace", "app").
+		Set("name", "app").
+		Set("project_id", Expr("tfe_project.default.id")).
+		Set("auto_apply", false).
+		Set("tag_names", []string{"a", "b"}).
+		SetIf(false, "description", "skipped")
+	resource.AddBlock("vcs_repo").Set("identifier", "org/repo")
+
+	imp := NewBlock("import").
+		Set("to", Expr("tfe_workspace.app")).
+		Set("id", "ws-123")
+
+	f.Add(resource, imp)
+
+	want := `resource "tfe_workspace" "app" {
+  name       = "app"
+  project_id = tfe_project.default.id
+  auto_apply = false
+  tag_names  = ["a", "b"]
+
+  vcs_repo {
+    identifier = "org/repo"
+  }
+}
+
+import {
+  to = tfe_workspace.app
+  id = "ws-123"
+}
+`
+	if got := string(f.Bytes()); got != want {
+		t.Errorf("Bytes() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+	if got := f.Count("resource"); got != 1 {
+		t.Errorf("Count(resource) = %d, want 1", got)
+	}
+}
+
+func TestFile_BytesObject(t *testing.T) {
+	f := NewFile("providers.tf")
+	terraform := NewBlock("terraform")
+	terraform.AddBlock("required_providers").
+		Set("tfe", map[string]interface{}{"source": "hashicorp/tfe"})
+	f.Add(terraform)
+
+	want := `terraform {
+  required_providers {
+    tfe = {
+      source = "hashicorp/tfe"
+    }
+  }
+}
+`
+	if got := string(f.Bytes()); got != want {
+		t.Errorf("Bytes() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested")
+	f := NewFile("main.tf")
+	f.Add(NewBlock("locals").Set("a", 1))
+
+	paths, err := WriteFiles(dir, []*File{f})
+	if err != nil {
+		t.Fatalf("WriteFiles() error = %v", err)
+	}
+	if len(paths) != 1 || paths[0] != filepath.Join(dir, "main.tf") {
+		t.Fatalf("WriteFiles() paths = %v", paths)
+	}
+
+	content, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(content) != "locals {\n  a = 1\n}\n" {
+		t.Errorf("unexpected content:\n%s", content)
+	}
+}
+
+func TestFile_BytesEscapes(t *testing.T) {
+	f := NewFile("main.tf")
+	f.Add(NewBlock("resource", "tfe_variable", "x").
+		Set("value", "echo ${HOME} %{if} \"x\"\nC:\\temp").
+		Set("description", "cost $5 and 100% <b>&</b>"))
+
+	want := `resource "tfe_variable" "x" {
+  value       = "echo $${HOME} %%{if} \"x\"\nC:\\temp"
+  description = "cost $5 and 100% <b>&</b>"
+}
+`
+	if got := string(f.Bytes()); got != want {
+		t.Errorf("Bytes() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
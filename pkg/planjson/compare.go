@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package planjson
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Comparison is the difference between the resource changes of two plans, a base plan and
+// an other plan compared against it
+type Comparison struct {
+	Added             []ResourceSummary `json:"added"`
+	Removed           []ResourceSummary `json:"removed"`
+	ActionChanged     []ActionChange    `json:"actionChanged"`
+	AttributesChanged []AttributeChange `json:"attributesChanged"`
+}
+
+// ActionChange is a resource the two plans take a different action on
+type ActionChange struct {
+	Address     string `json:"address"`
+	Action      string `json:"action"`
+	OtherAction string `json:"otherAction"`
+}
+
+// AttributeChange is a resource both plans take the same action on, but with a different diff
+// for some attributes. Attributes lists the names only, values are never included.
+type AttributeChange struct {
+	Address    string   `json:"address"`
+	Action     string   `json:"action"`
+	Attributes []string `json:"attributes"`
+}
+
+// Differences returns the number of resources that differ between the plans
+func (c Comparison) Differences() int {
+	return len(c.Added) + len(c.Removed) + len(c.ActionChanged) + len(c.AttributesChanged)
+}
+
+// Compare reports the resources that only one of the plans changes, those the plans take
+// a different action on, and those whose attribute diffs differ. Resources neither plan
+// changes, imports or moves are ignored.
+func Compare(base *Plan, other *Plan) Comparison {
+	c := Comparison{
+		Added:             []ResourceSummary{},
+		Removed:           []ResourceSummary{},
+		ActionChanged:     []ActionChange{},
+		AttributesChanged: []AttributeChange{},
+	}
+	baseChanges := changedResources(base)
+	otherChanges := changedResources(other)
+
+	for _, address := range sortedAddresses(baseChanges, otherChanges) {
+		b, inBase := baseChanges[address]
+		o, inOther := otherChanges[address]
+		switch {
+		case !inOther:
+			c.Removed = append(c.Removed, summarizeResource(b))
+		case !inBase:
+			c.Added = append(c.Added, summarizeResource(o))
+		case b.Change.Action() != o.Change.Action():
+			c.ActionChanged = append(c.ActionChanged, ActionChange{
+				Address:     address,
+				Action:      b.Change.Action(),
+				OtherAction: o.Change.Action(),
+			})
+		default:
+			if names := differentDiffs(b.Change, o.Change); len(names) > 0 {
+				c.AttributesChanged = append(c.AttributesChanged, AttributeChange{
+					Address:    address,
+					Action:     b.Change.Action(),
+					Attributes: names,
+				})
+			}
+		}
+	}
+	return c
+}
+
+// changedResources returns the resource changes of a plan that are in a summary, by address
+func changedResources(p *Plan) map[string]ResourceChange {
+	changes := map[string]ResourceChange{}
+	for _, rc := range p.ResourceChanges {
+		if len(summarizeResource(rc).Kinds) > 0 {
+			changes[rc.Address] = rc
+		}
+	}
+	return changes
+}
+
+// differentDiffs returns the top level attributes whose before value, after value or known
+// after apply status differ between two changes, sorted by name
+func differentDiffs(a Change, b Change) []string {
+	type diff struct {
+		before, after interface{}
+		unknown       bool
+	}
+	diffs := func(c Change) map[string]diff {
+		before := decodeObject(c.Before)
+		after := decodeObject(c.After)
+		unknown := decodeValue(c.AfterUnknown)
+		d := map[string]diff{}
+		for _, attr := range c.ChangedAttributes() {
+			d[attr.Name] = diff{before[attr.Name], after[attr.Name], containsTrue(valueAt(unknown, attr.Name))}
+		}
+		return d
+	}
+
+	aDiffs, bDiffs := diffs(a), diffs(b)
+	names := map[string]bool{}
+	for name, d := range aDiffs {
+		if !reflect.DeepEqual(d, bDiffs[name]) {
+			names[name] = true
+		}
+	}
+	for name, d := range bDiffs {
+		if !reflect.DeepEqual(d, aDiffs[name]) {
+			names[name] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func sortedAddresses(maps ...map[string]ResourceChange) []string {
+	seen := map[string]bool{}
+	var addresses []string
+	for _, m := range maps {
+		for address := range m {
+			if !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
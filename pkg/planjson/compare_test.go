@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package planjson
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testBasePlan = `{
+  "resource_changes": [
+    {"address": "aws_instance.web", "change": {"actions": ["update"],
+      "before": {"instance_type": "t3.small", "tags": {"env": "dev"}}, "after": {"instance_type": "t3.medium", "tags": {"env": "dev"}}}},
+    {"address": "aws_db_instance.main", "change": {"actions": ["update"],
+      "before": {"password": "a"}, "after": {"password": "b"}, "after_sensitive": {"password": true}}},
+    {"address": "aws_s3_bucket.logs", "change": {"actions": ["create"], "before": null, "after": {"bucket": "logs"}}},
+    {"address": "random_pet.name", "change": {"actions": ["update"], "before": {"length": 2}, "after": {"length": 3}}},
+    {"address": "aws_iam_role.ci", "change": {"actions": ["no-op"]}}
+  ]
+}`
+
+const testOtherPlan = `{
+  "resource_changes": [
+    {"address": "aws_instance.web", "change": {"actions": ["update"],
+      "before": {"instance_type": "t3.small", "tags": {"env": "dev"}}, "after": {"instance_type": "t3.large", "tags": {"env": "prod"}}}},
+    {"address": "aws_db_instance.main", "change": {"actions": ["update"],
+      "before": {"password": "a"}, "after": {"password": "b"}, "after_sensitive": {"password": true}}},
+    {"address": "module.logs.aws_s3_bucket.this", "change": {"actions": ["create"], "before": null, "after": {"bucket": "logs"}}},
+    {"address": "random_pet.name", "change": {"actions": ["delete", "create"], "before": {"length": 2}, "after": {"length": 3}}},
+    {"address": "aws_iam_role.ci", "change": {"actions": ["no-op"]}}
+  ]
+}`
+
+func TestCompare(t *testing.T) {
+	base, err := Parse([]byte(testBasePlan))
+	if err != nil {
+		t.Fatalf("Parse(base) error = %v", err)
+	}
+	other, err := Parse([]byte(testOtherPlan))
+	if err != nil {
+		t.Fatalf("Parse(other) error = %v", err)
+	}
+
+	c := Compare(base, other)
+	if len(c.Added) != 1 || c.Added[0].Address != "module.logs.aws_s3_bucket.this" {
+		t.Errorf("Added = %+v, want module.logs.aws_s3_bucket.this", c.Added)
+	}
+	if len(c.Removed) != 1 || c.Removed[0].Address != "aws_s3_bucket.logs" {
+		t.Errorf("Removed = %+v, want aws_s3_bucket.logs", c.Removed)
+	}
+	wantActions := []ActionChange{{Address: "random_pet.name", Action: ActionUpdate, OtherAction: ActionReplace}}
+	if !reflect.DeepEqual(c.ActionChanged, wantActions) {
+		t.Errorf("ActionChanged = %+v, want %+v", c.ActionChanged, wantActions)
+	}
+	wantAttributes := []AttributeChange{{Address: "aws_instance.web", Action: ActionUpdate, Attributes: []string{"instance_type", "tags"}}}
+	if !reflect.DeepEqual(c.AttributesChanged, wantAttributes) {
+		t.Errorf("AttributesChanged = %+v, want %+v", c.AttributesChanged, wantAttributes)
+	}
+	if got := c.Differences(); got != 4 {
+		t.Errorf("Differences() = %d, want 4", got)
+	}
+
+	if same := Compare(base, base); same.Differences() != 0 {
+		t.Errorf("Compare(base, base) = %+v, want no differences", same)
+	}
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package planjson reads the parts of Terraform's JSON plan format
+// (terraform show -json) that tfx reports on.
+package planjson
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Plan is a Terraform JSON plan
+type Plan struct {
+	FormatVersion    string           `json:"format_version"`
+	TerraformVersion string           `json:"terraform_version"`
+	ResourceChanges  []ResourceChange `json:"resource_changes"`
+	ResourceDrift    []ResourceChange `json:"resource_drift"`
+	Checks           []Check          `json:"checks"`
+}
+
+// ResourceChange is a planned change, or detected drift, for a single resource instance
+type ResourceChange struct {
+	Address         string `json:"address"`
+	PreviousAddress string `json:"previous_address,omitempty"`
+	ModuleAddress   string `json:"module_address,omitempty"`
+	Mode            string `json:"mode"`
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	ProviderName    string `json:"provider_name"`
+	Change          Change `json:"change"`
+}
+
+// Change describes the actions for a resource change and the values before and after it.
+// Values are kept raw as they are only compared, never shown.
+type Change struct {
+	Actions         []string        `json:"actions"`
+	Before          json.RawMessage `json:"before,omitempty"`
+	After           json.RawMessage `json:"after,omitempty"`
+	AfterUnknown    json.RawMessage `json:"after_unknown,omitempty"`
+	BeforeSensitive json.RawMessage `json:"before_sensitive,omitempty"`
+	AfterSensitive  json.RawMessage `json:"after_sensitive,omitempty"`
+	Importing       *Importing      `json:"importing,omitempty"`
+}
+
+// Importing is set on a change that imports an existing object
+type Importing struct {
+	ID string `json:"id,omitempty"`
+}
+
+// Action names for a change, a delete and create pair is reported as a replace
+const (
+	ActionNoOp    = "no-op"
+	ActionCreate  = "create"
+	ActionRead    = "read"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionReplace = "replace"
+)
+
+// Action returns a single action name for the change
+func (c Change) Action() string {
+	switch len(c.Actions) {
+	case 0:
+		return ActionNoOp
+	case 1:
+		return c.Actions[0]
+	}
+	if containsAll(c.Actions, ActionCreate, ActionDelete) {
+		return ActionReplace
+	}
+	return strings.Join(c.Actions, ",")
+}
+
+// Check status values
+const (
+	CheckPass    = "pass"
+	CheckFail    = "fail"
+	CheckError   = "error"
+	CheckUnknown = "unknown"
+)
+
+// Check is the result of the checks (preconditions, postconditions and check blocks)
+// for one configuration object
+type Check struct {
+	Address   CheckAddress    `json:"address"`
+	Status    string          `json:"status"`
+	Instances []CheckInstance `json:"instances,omitempty"`
+}
+
+// CheckAddress identifies a checkable object
+type CheckAddress struct {
+	Kind      string `json:"kind"`
+	ToDisplay string `json:"to_display"`
+}
+
+// CheckInstance is the check result for a single instance of a checkable object
+type CheckInstance struct {
+	Address  CheckAddress   `json:"address"`
+	Status   string         `json:"status"`
+	Problems []CheckProblem `json:"problems,omitempty"`
+}
+
+// CheckProblem is a failure message reported by a check
+type CheckProblem struct {
+	Message string `json:"message"`
+}
+
+// Failed returns true if the check failed or could not be evaluated
+func (c Check) Failed() bool {
+	return c.Status == CheckFail || c.Status == CheckError
+}
+
+// Messages returns the problem messages of every failed instance
+func (c Check) Messages() []string {
+	var messages []string
+	for _, i := range c.Instances {
+		for _, p := range i.Problems {
+			if p.Message != "" {
+				messages = append(messages, p.Message)
+			}
+		}
+	}
+	return messages
+}
+
+// Parse parses a Terraform JSON plan
+func Parse(b []byte) (*Plan, error) {
+	var p Plan
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// DriftedResources returns the resources that changed outside of Terraform, skipping
+// entries without an actual change
+func (p *Plan) DriftedResources() []ResourceChange {
+	var drifted []ResourceChange
+	for _, rc := range p.ResourceDrift {
+		if rc.Change.Action() != ActionNoOp {
+			drifted = append(drifted, rc)
+		}
+	}
+	return drifted
+}
+
+// FailedChecks returns every check that failed or errored
+func (p *Plan) FailedChecks() []Check {
+	var failed []Check
+	for _, c := range p.Checks {
+		if c.Failed() {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+func containsAll(values []string, want ...string) bool {
+	for _, w := range want {
+		if !slices.Contains(values, w) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package planjson
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testPlan = `{
+  "format_version": "1.2",
+  "terraform_version": "1.9.5",
+  "resource_drift": [
+    {"address": "aws_s3_bucket.logs", "type": "aws_s3_bucket", "name": "logs", "change": {"actions": ["update"]}},
+    {"address": "aws_iam_role.ci", "type": "aws_iam_role", "name": "ci", "change": {"actions": ["delete"]}},
+    {"address": "aws_sqs_queue.jobs", "type": "aws_sqs_queue", "name": "jobs", "change": {"actions": ["no-op"]}}
+  ],
+  "checks": [
+    {"address": {"kind": "check", "to_display": "check.health"}, "status": "fail",
+     "instances": [{"address": {"to_display": "check.health"}, "status": "fail", "problems": [{"message": "endpoint returned 503"}]}]},
+    {"address": {"kind": "resource", "to_display": "aws_s3_bucket.logs"}, "status": "pass"},
+    {"address": {"kind": "output_value", "to_display": "output.url"}, "status": "error"}
+  ]
+}`
+
+func TestChangeAction(t *testing.T) {
+	tests := []struct {
+		actions []string
+		want    string
+	}{
+		{nil, ActionNoOp},
+		{[]string{"create"}, ActionCreate},
+		{[]string{"delete", "create"}, ActionReplace},
+		{[]string{"create", "delete"}, ActionReplace},
+	}
+	for _, tt := range tests {
+		if got := (Change{Actions: tt.actions}).Action(); got != tt.want {
+			t.Errorf("Action(%v) = %q, want %q", tt.actions, got, tt.want)
+		}
+	}
+}
+
+func TestDriftAndChecks(t *testing.T) {
+	p, err := Parse([]byte(testPlan))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var drifted []string
+	for _, rc := range p.DriftedResources() {
+		drifted = append(drifted, rc.Address+":"+rc.Change.Action())
+	}
+	if want := []string{"aws_s3_bucket.logs:update", "aws_iam_role.ci:delete"}; !reflect.DeepEqual(drifted, want) {
+		t.Errorf("DriftedResources() = %v, want %v", drifted, want)
+	}
+
+	failed := p.FailedChecks()
+	if len(failed) != 2 {
+		t.Fatalf("FailedChecks() returned %d checks, want 2", len(failed))
+	}
+	if got := failed[0].Messages(); !reflect.DeepEqual(got, []string{"endpoint returned 503"}) {
+		t.Errorf("Messages() = %v", got)
+	}
+	if failed[1].Address.ToDisplay != "output.url" {
+		t.Errorf("FailedChecks()[1] = %s, want output.url", failed[1].Address.ToDisplay)
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package planjson
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Kinds of change a resource can be listed under besides its action. An import or move can
+// accompany any action, including a no-op.
+const (
+	KindImport = "import"
+	KindMove   = "move"
+)
+
+// SummaryKinds are the kinds a summary groups resources by, in display order
+var SummaryKinds = []string{ActionCreate, ActionUpdate, ActionReplace, ActionDelete, ActionRead, KindImport, KindMove}
+
+// RootModule is the module name used for resources in the root module
+const RootModule = "root"
+
+// Summary groups the resource changes of a plan by kind, module and provider
+type Summary struct {
+	TerraformVersion string            `json:"terraformVersion"`
+	Counts           map[string]int    `json:"counts"`
+	Resources        []ResourceSummary `json:"resources"`
+	Modules          []GroupSummary    `json:"modules"`
+	Providers        []GroupSummary    `json:"providers"`
+}
+
+// ResourceSummary is a resource the plan changes
+type ResourceSummary struct {
+	Address           string             `json:"address"`
+	PreviousAddress   string             `json:"previousAddress,omitempty"`
+	Module            string             `json:"module"`
+	Provider          string             `json:"provider"`
+	Action            string             `json:"action"`
+	Kinds             []string           `json:"kinds"`
+	ImportID          string             `json:"importId,omitempty"`
+	ChangedAttributes []ChangedAttribute `json:"changedAttributes,omitempty"`
+	Destructive       bool               `json:"destructive"`
+}
+
+// ChangedAttribute is a top level attribute an update or replace changes. Values are never
+// included, Sensitive marks attributes whose values are sensitive.
+type ChangedAttribute struct {
+	Name       string `json:"name"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	KnownAfter bool   `json:"knownAfterApply,omitempty"`
+}
+
+// GroupSummary counts the resources changed in a module or by a provider
+type GroupSummary struct {
+	Name   string         `json:"name"`
+	Counts map[string]int `json:"counts"`
+	Total  int            `json:"total"`
+}
+
+// Destructive returns the number of resources the plan deletes or replaces
+func (s Summary) Destructive() int {
+	return s.Counts[ActionDelete] + s.Counts[ActionReplace]
+}
+
+// ByKind returns the resources listed under a kind
+func (s Summary) ByKind(kind string) []ResourceSummary {
+	var resources []ResourceSummary
+	for _, r := range s.Resources {
+		for _, k := range r.Kinds {
+			if k == kind {
+				resources = append(resources, r)
+				break
+			}
+		}
+	}
+	return resources
+}
+
+// Summarize summarizes the resource changes of the plan. Resources that are not changed,
+// imported or moved are left out.
+func (p *Plan) Summarize() Summary {
+	s := Summary{
+		TerraformVersion: p.TerraformVersion,
+		Counts:           map[string]int{},
+		Resources:        []ResourceSummary{},
+		Modules:          []GroupSummary{},
+		Providers:        []GroupSummary{},
+	}
+	modules := map[string]*GroupSummary{}
+	providers := map[string]*GroupSummary{}
+
+	for _, rc := range p.ResourceChanges {
+		r := summarizeResource(rc)
+		if len(r.Kinds) == 0 {
+			continue
+		}
+		s.Resources = append(s.Resources, r)
+		for _, k := range r.Kinds {
+			s.Counts[k]++
+		}
+		countGroup(modules, r.Module, r.Kinds)
+		countGroup(providers, r.Provider, r.Kinds)
+	}
+
+	s.Modules = sortedGroups(modules)
+	s.Providers = sortedGroups(providers)
+	return s
+}
+
+func summarizeResource(rc ResourceChange) ResourceSummary {
+	r := ResourceSummary{
+		Address:         rc.Address,
+		PreviousAddress: rc.PreviousAddress,
+		Module:          rc.ModuleAddress,
+		Provider:        strings.TrimPrefix(rc.ProviderName, "registry.terraform.io/"),
+		Action:          rc.Change.Action(),
+		Kinds:           []string{},
+	}
+	if r.Module == "" {
+		r.Module = RootModule
+	}
+	if r.Action != ActionNoOp {
+		r.Kinds = append(r.Kinds, r.Action)
+	}
+	if rc.Change.Importing != nil {
+		r.Kinds = append(r.Kinds, KindImport)
+		r.ImportID = rc.Change.Importing.ID
+	}
+	if rc.PreviousAddress != "" && rc.PreviousAddress != rc.Address {
+		r.Kinds = append(r.Kinds, KindMove)
+	}
+	if r.Action == ActionUpdate || r.Action == ActionReplace {
+		r.ChangedAttributes = rc.Change.ChangedAttributes()
+	}
+	r.Destructive = r.Action == ActionDelete || r.Action == ActionReplace
+	return r
+}
+
+// ChangedAttributes returns the top level attributes whose values differ before and after the
+// change, or are only known after apply, sorted by name
+func (c Change) ChangedAttributes() []ChangedAttribute {
+	before := decodeObject(c.Before)
+	after := decodeObject(c.After)
+	unknown := decodeValue(c.AfterUnknown)
+	beforeSensitive := decodeValue(c.BeforeSensitive)
+	afterSensitive := decodeValue(c.AfterSensitive)
+
+	names := map[string]bool{}
+	for k := range before {
+		names[k] = true
+	}
+	for k := range after {
+		names[k] = true
+	}
+	if m, ok := unknown.(map[string]interface{}); ok {
+		for k := range m {
+			names[k] = true
+		}
+	}
+
+	var changed []ChangedAttribute
+	for name := range names {
+		knownAfter := containsTrue(valueAt(unknown, name))
+		if !knownAfter && reflect.DeepEqual(before[name], after[name]) {
+			continue
+		}
+		changed = append(changed, ChangedAttribute{
+			Name:       name,
+			Sensitive:  containsTrue(valueAt(beforeSensitive, name)) || containsTrue(valueAt(afterSensitive, name)),
+			KnownAfter: knownAfter,
+		})
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Name < changed[j].Name })
+	return changed
+}
+
+func countGroup(groups map[string]*GroupSummary, name string, kinds []string) {
+	g, ok := groups[name]
+	if !ok {
+		g = &GroupSummary{Name: name, Counts: map[string]int{}}
+		groups[name] = g
+	}
+	for _, k := range kinds {
+		g.Counts[k]++
+	}
+	g.Total++
+}
+
+// sortedGroups returns the groups sorted by name, with the root module first
+func sortedGroups(groups map[string]*GroupSummary) []GroupSummary {
+	sorted := make([]GroupSummary, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, *g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if (sorted[i].Name == RootModule) != (sorted[j].Name == RootModule) {
+			return sorted[i].Name == RootModule
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+func decodeValue(raw json.RawMessage) interface{} {
+	var v interface{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &v)
+	}
+	return v
+}
+
+func decodeObject(raw json.RawMessage) map[string]interface{} {
+	m, _ := decodeValue(raw).(map[string]interface{})
+	return m
+}
+
+// valueAt returns the value of key in an object. A true value marks the whole object, as
+// Terraform does for sensitive and unknown values.
+func valueAt(v interface{}, key string) interface{} {
+	switch t := v.(type) {
+	case bool:
+		return t
+	case map[string]interface{}:
+		return t[key]
+	}
+	return nil
+}
+
+// containsTrue returns true if v is true or holds a true value at any depth
+func containsTrue(v interface{}) bool {
+	switch t := v.(type) {
+	case bool:
+		return t
+	case map[string]interface{}:
+		for _, e := range t {
+			if containsTrue(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range t {
+			if containsTrue(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package planjson
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testChangesPlan = `{
+  "terraform_version": "1.9.5",
+  "resource_changes": [
+    {"address": "aws_instance.web", "provider_name": "registry.terraform.io/hashicorp/aws",
+     "change": {"actions": ["create"], "before": null, "after": {"ami": "ami-1"}, "after_unknown": {"id": true}}},
+    {"address": "aws_db_instance.main", "provider_name": "registry.terraform.io/hashicorp/aws",
+     "change": {"actions": ["update"],
+       "before": {"password": "old", "instance_class": "db.t3.small", "port": 5432},
+       "after": {"password": "new", "instance_class": "db.t3.medium", "port": 5432},
+       "after_unknown": {"status": true}, "before_sensitive": {"password": true}, "after_sensitive": {"password": true}}},
+    {"address": "module.net.aws_subnet.a", "module_address": "module.net", "provider_name": "registry.terraform.io/hashicorp/aws",
+     "change": {"actions": ["delete", "create"], "before": {"cidr_block": "10.0.0.0/24"}, "after": {"cidr_block": "10.0.1.0/24"}}},
+    {"address": "module.net.aws_vpc.main", "module_address": "module.net", "previous_address": "aws_vpc.main",
+     "provider_name": "registry.terraform.io/hashicorp/aws", "change": {"actions": ["no-op"]}},
+    {"address": "random_pet.name", "provider_name": "registry.terraform.io/hashicorp/random",
+     "change": {"actions": ["delete"]}},
+    {"address": "aws_s3_bucket.logs", "provider_name": "registry.terraform.io/hashicorp/aws",
+     "change": {"actions": ["no-op"], "importing": {"id": "logs-bucket"}}},
+    {"address": "aws_iam_role.ci", "provider_name": "registry.terraform.io/hashicorp/aws",
+     "change": {"actions": ["no-op"]}}
+  ]
+}`
+
+func TestSummarize(t *testing.T) {
+	p, err := Parse([]byte(testChangesPlan))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	s := p.Summarize()
+
+	wantCounts := map[string]int{
+		ActionCreate: 1, ActionUpdate: 1, ActionReplace: 1, ActionDelete: 1, KindImport: 1, KindMove: 1,
+	}
+	if !reflect.DeepEqual(s.Counts, wantCounts) {
+		t.Errorf("Counts = %v, want %v", s.Counts, wantCounts)
+	}
+	if len(s.Resources) != 6 {
+		t.Errorf("got %d resources, want 6 without the no-op", len(s.Resources))
+	}
+	if got := s.Destructive(); got != 2 {
+		t.Errorf("Destructive() = %d, want 2", got)
+	}
+
+	moved := s.ByKind(KindMove)
+	if len(moved) != 1 || moved[0].PreviousAddress != "aws_vpc.main" {
+		t.Errorf("ByKind(move) = %+v, want module.net.aws_vpc.main from aws_vpc.main", moved)
+	}
+	imported := s.ByKind(KindImport)
+	if len(imported) != 1 || imported[0].ImportID != "logs-bucket" {
+		t.Errorf("ByKind(import) = %+v, want aws_s3_bucket.logs with id logs-bucket", imported)
+	}
+	if replaced := s.ByKind(ActionReplace); len(replaced) != 1 || !replaced[0].Destructive {
+		t.Errorf("ByKind(replace) = %+v, want one destructive change", replaced)
+	}
+
+	var modules []string
+	for _, g := range s.Modules {
+		modules = append(modules, g.Name)
+	}
+	if want := []string{RootModule, "module.net"}; !reflect.DeepEqual(modules, want) {
+		t.Errorf("Modules = %v, want %v", modules, want)
+	}
+	var providers []string
+	for _, g := range s.Providers {
+		providers = append(providers, g.Name)
+	}
+	if want := []string{"hashicorp/aws", "hashicorp/random"}; !reflect.DeepEqual(providers, want) {
+		t.Errorf("Providers = %v, want %v", providers, want)
+	}
+	if net := s.Modules[1]; net.Total != 2 || net.Counts[ActionReplace] != 1 || net.Counts[KindMove] != 1 {
+		t.Errorf("module.net = %+v, want 1 replace and 1 move", net)
+	}
+}
+
+func TestChangedAttributes(t *testing.T) {
+	p, err := Parse([]byte(testChangesPlan))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	got := p.ResourceChanges[1].Change.ChangedAttributes()
+	want := []ChangedAttribute{
+		{Name: "instance_class"},
+		{Name: "password", Sensitive: true},
+		{Name: "status", KnownAfter: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedAttributes() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package runlog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GitHubAnnotation returns the diagnostic as a GitHub Actions workflow command, so it is shown
+// as an annotation on the file and line it refers to
+func (d Diagnostic) GitHubAnnotation() string {
+	command := "error"
+	if d.Severity == SeverityWarning {
+		command = "warning"
+	}
+
+	var props []string
+	if d.Range != nil && d.Range.Filename != "" {
+		props = append(props, "file="+escapeProperty(d.Range.Filename))
+		if d.Range.Start.Line > 0 {
+			props = append(props, fmt.Sprintf("line=%d", d.Range.Start.Line))
+			if d.Range.End.Line >= d.Range.Start.Line {
+				props = append(props, fmt.Sprintf("endLine=%d", d.Range.End.Line))
+			}
+		}
+		if d.Range.Start.Column > 0 && d.Range.End.Line == d.Range.Start.Line {
+			props = append(props, fmt.Sprintf("col=%d", d.Range.Start.Column))
+			if d.Range.End.Column > 0 {
+				props = append(props, fmt.Sprintf("endColumn=%d", d.Range.End.Column))
+			}
+		}
+	}
+	props = append(props, "title="+escapeProperty(d.Summary))
+
+	message := d.Detail
+	if message == "" {
+		message = d.Summary
+	}
+	if d.Address != "" {
+		message = d.Address + ": " + message
+	}
+	return fmt.Sprintf("::%s %s::%s", command, strings.Join(props, ","), escapeData(message))
+}
+
+// escapeData escapes the message of a workflow command
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes a property value of a workflow command
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
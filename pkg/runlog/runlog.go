@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package runlog parses plan and apply logs into typed events. Logs from runs with structured
+// run output are Terraform's machine readable UI messages, one JSON object per line; other
+// lines are kept as text and scanned for diagnostics as Terraform prints them.
+package runlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event types, as Terraform names them in its machine readable UI
+const (
+	TypeText            = "text"
+	TypeVersion         = "version"
+	TypeLog             = "log"
+	TypeDiagnostic      = "diagnostic"
+	TypePlannedChange   = "planned_change"
+	TypeResourceDrift   = "resource_drift"
+	TypeChangeSummary   = "change_summary"
+	TypeOutputs         = "outputs"
+	TypeApplyStart      = "apply_start"
+	TypeApplyProgress   = "apply_progress"
+	TypeApplyComplete   = "apply_complete"
+	TypeApplyErrored    = "apply_errored"
+	TypeRefreshStart    = "refresh_start"
+	TypeRefreshComplete = "refresh_complete"
+)
+
+// Diagnostic severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Event is a single log message
+type Event struct {
+	Type       string            `json:"type"`
+	Level      string            `json:"level,omitempty"`
+	Message    string            `json:"message"`
+	Timestamp  time.Time         `json:"timestamp,omitempty"`
+	Diagnostic *Diagnostic       `json:"diagnostic,omitempty"`
+	Resource   *ResourceProgress `json:"resource,omitempty"`
+	Changes    *ChangeSummary    `json:"changes,omitempty"`
+	Outputs    map[string]Output `json:"outputs,omitempty"`
+}
+
+// Diagnostic is an error or warning reported by Terraform
+type Diagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail,omitempty"`
+	Address  string `json:"address,omitempty"`
+	Range    *Range `json:"range,omitempty"`
+}
+
+// Range is the source location a diagnostic refers to
+type Range struct {
+	Filename string   `json:"filename"`
+	Start    Position `json:"start"`
+	End      Position `json:"end"`
+}
+
+// Position is a line and column in a source file
+type Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// ResourceProgress is a resource being planned, refreshed or applied
+type ResourceProgress struct {
+	Address        string  `json:"address"`
+	Action         string  `json:"action,omitempty"`
+	IDKey          string  `json:"idKey,omitempty"`
+	IDValue        string  `json:"idValue,omitempty"`
+	ElapsedSeconds float64 `json:"elapsedSeconds,omitempty"`
+}
+
+// ChangeSummary counts the changes of a plan or apply
+type ChangeSummary struct {
+	Operation string `json:"operation"`
+	Add       int    `json:"add"`
+	Change    int    `json:"change"`
+	Import    int    `json:"import"`
+	Remove    int    `json:"remove"`
+}
+
+// Output is a root module output value, sensitive values are not included by Terraform
+type Output struct {
+	Sensitive bool            `json:"sensitive"`
+	Type      json.RawMessage `json:"type,omitempty"`
+	Value     json.RawMessage `json:"value,omitempty"`
+	Action    string          `json:"action,omitempty"`
+}
+
+// Location returns the diagnostic source as file:line, or an empty string when it has none
+func (d Diagnostic) Location() string {
+	if d.Range == nil || d.Range.Filename == "" {
+		return ""
+	}
+	if d.Range.Start.Line == 0 {
+		return d.Range.Filename
+	}
+	return fmt.Sprintf("%s:%d", d.Range.Filename, d.Range.Start.Line)
+}
+
+// uiMessage is a line of Terraform's machine readable UI
+type uiMessage struct {
+	Level      string            `json:"@level"`
+	Message    string            `json:"@message"`
+	Timestamp  time.Time         `json:"@timestamp"`
+	Type       string            `json:"type"`
+	Diagnostic *uiDiagnostic     `json:"diagnostic"`
+	Change     *uiHook           `json:"change"`
+	Hook       *uiHook           `json:"hook"`
+	Changes    *ChangeSummary    `json:"changes"`
+	Outputs    map[string]Output `json:"outputs"`
+}
+
+type uiDiagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Detail   string `json:"detail"`
+	Address  string `json:"address"`
+	Range    *Range `json:"range"`
+}
+
+type uiHook struct {
+	Resource struct {
+		Addr string `json:"addr"`
+	} `json:"resource"`
+	Action         string  `json:"action"`
+	IDKey          string  `json:"id_key"`
+	IDValue        string  `json:"id_value"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+}
+
+// Parse turns log lines into events. Structured lines become typed events, other lines become
+// text events, with diagnostics picked out of Terraform's human readable error and warning blocks.
+func Parse(lines []string) []Event {
+	events := make([]Event, 0, len(lines))
+	var text *textDiagnostic
+	for _, line := range lines {
+		if e, ok := parseStructured(line); ok {
+			events = append(events, e)
+			continue
+		}
+
+		e := Event{Type: TypeText, Message: line}
+		if d := text.scan(line); d != nil {
+			text = d
+			e.Diagnostic = &d.Diagnostic
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// Diagnostics returns the errors and warnings in the events. A diagnostic from a human readable
+// block is returned once, with the source location that followed it.
+func Diagnostics(events []Event) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, e := range events {
+		if e.Diagnostic != nil {
+			diagnostics = append(diagnostics, *e.Diagnostic)
+		}
+	}
+	return diagnostics
+}
+
+// IsStructured returns true if any of the events came from structured run output
+func IsStructured(events []Event) bool {
+	for _, e := range events {
+		if e.Type != TypeText {
+			return true
+		}
+	}
+	return false
+}
+
+func parseStructured(line string) (Event, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "{") {
+		return Event{}, false
+	}
+	var m uiMessage
+	if err := json.Unmarshal([]byte(trimmed), &m); err != nil || m.Type == "" {
+		return Event{}, false
+	}
+
+	e := Event{
+		Type:      m.Type,
+		Level:     m.Level,
+		Message:   m.Message,
+		Timestamp: m.Timestamp,
+		Changes:   m.Changes,
+		Outputs:   m.Outputs,
+	}
+	if d := m.Diagnostic; d != nil {
+		e.Diagnostic = &Diagnostic{Severity: d.Severity, Summary: d.Summary, Detail: d.Detail, Address: d.Address, Range: d.Range}
+	}
+	hook := m.Hook
+	if hook == nil {
+		hook = m.Change
+	}
+	if hook != nil && hook.Resource.Addr != "" {
+		e.Resource = &ResourceProgress{
+			Address:        hook.Resource.Addr,
+			Action:         hook.Action,
+			IDKey:          hook.IDKey,
+			IDValue:        hook.IDValue,
+			ElapsedSeconds: hook.ElapsedSeconds,
+		}
+	}
+	return e, true
+}
+
+var (
+	// textDiagnosticStart matches the first line of a human readable diagnostic, such as
+	// "│ Error: Unsupported argument"
+	textDiagnosticStart = regexp.MustCompile(`^[│╷╵\s]*(Error|Warning): (.+)$`)
+	// textDiagnosticSource matches the source line of a diagnostic, such as
+	// "│   on main.tf line 12, in resource "aws_instance" "web":"
+	textDiagnosticSource = regexp.MustCompile(`^[│\s]*on (\S+) line (\d+)`)
+)
+
+// textDiagnostic tracks a human readable diagnostic while its source line may still follow
+type textDiagnostic struct {
+	Diagnostic
+	lines int
+}
+
+// scan returns a new diagnostic when line starts one, and otherwise fills in the source
+// location of the current diagnostic from the lines that follow it
+func (t *textDiagnostic) scan(line string) *textDiagnostic {
+	if m := textDiagnosticStart.FindStringSubmatch(line); m != nil {
+		return &textDiagnostic{Diagnostic: Diagnostic{Severity: strings.ToLower(m[1]), Summary: strings.TrimSpace(m[2])}}
+	}
+	if t == nil || t.Range != nil || t.lines > 3 {
+		return nil
+	}
+	t.lines++
+	if m := textDiagnosticSource.FindStringSubmatch(line); m != nil {
+		lineNumber, _ := strconv.Atoi(m[2])
+		t.Range = &Range{Filename: m[1], Start: Position{Line: lineNumber}, End: Position{Line: lineNumber}}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package runlog
+
+import (
+	"reflect"
+	"testing"
+)
+
+var structuredLog = []string{
+	`{"@level":"info","@message":"Terraform 1.9.5","@module":"terraform.ui","type":"version","terraform":"1.9.5","ui":"1.2"}`,
+	`{"@level":"info","@message":"aws_instance.web: Plan to create","type":"planned_change","change":{"resource":{"addr":"aws_instance.web"},"action":"create"}}`,
+	`{"@level":"info","@message":"aws_instance.web: Creation complete after 32s [id=i-0abc]","type":"apply_complete","hook":{"resource":{"addr":"aws_instance.web"},"action":"create","id_key":"id","id_value":"i-0abc","elapsed_seconds":32}}`,
+	`{"@level":"warn","@message":"Warning: Deprecated attribute","type":"diagnostic","diagnostic":{"severity":"warning","summary":"Deprecated attribute","detail":"Use tags_all instead.","range":{"filename":"main.tf","start":{"line":12,"column":3},"end":{"line":12,"column":14}}}}`,
+	`{"@level":"error","@message":"Error: Invalid count argument","type":"diagnostic","diagnostic":{"severity":"error","summary":"Invalid count argument","detail":"The \"count\" value depends on\nresource attributes.","address":"module.net.aws_subnet.a"}}`,
+	`{"@level":"info","@message":"Plan: 1 to add, 0 to change, 0 to destroy.","type":"change_summary","changes":{"add":1,"change":0,"import":0,"remove":0,"operation":"plan"}}`,
+	`{"@level":"info","@message":"Outputs: 1","type":"outputs","outputs":{"url":{"sensitive":false,"type":"string","value":"https://example.com"}}}`,
+}
+
+func TestParseStructured(t *testing.T) {
+	events := Parse(structuredLog)
+	if len(events) != len(structuredLog) || !IsStructured(events) {
+		t.Fatalf("Parse() returned %d events, structured %t", len(events), IsStructured(events))
+	}
+
+	planned := events[1].Resource
+	if planned == nil || planned.Address != "aws_instance.web" || planned.Action != "create" {
+		t.Errorf("planned_change resource = %+v", planned)
+	}
+	applied := events[2].Resource
+	if applied == nil || applied.IDValue != "i-0abc" || applied.ElapsedSeconds != 32 {
+		t.Errorf("apply_complete resource = %+v", applied)
+	}
+	if c := events[5].Changes; c == nil || c.Add != 1 || c.Operation != "plan" {
+		t.Errorf("change_summary changes = %+v", c)
+	}
+	if o, ok := events[6].Outputs["url"]; !ok || string(o.Value) != `"https://example.com"` {
+		t.Errorf("outputs = %+v", events[6].Outputs)
+	}
+
+	diagnostics := Diagnostics(events)
+	if len(diagnostics) != 2 {
+		t.Fatalf("Diagnostics() returned %d diagnostics, want 2", len(diagnostics))
+	}
+	if got := diagnostics[0].Location(); got != "main.tf:12" {
+		t.Errorf("Location() = %q, want main.tf:12", got)
+	}
+	if diagnostics[1].Severity != SeverityError || diagnostics[1].Location() != "" {
+		t.Errorf("diagnostic = %+v, want an error without a location", diagnostics[1])
+	}
+}
+
+func TestParseText(t *testing.T) {
+	events := Parse([]string{
+		"Terraform v1.9.5",
+		"╷",
+		"│ Error: Unsupported argument",
+		"│ ",
+		`│   on main.tf line 7, in resource "aws_instance" "web":`,
+		`│    7:   ami_id = "ami-1"`,
+		"╵",
+		"Warning: Value for undeclared variable",
+	})
+	if IsStructured(events) {
+		t.Error("IsStructured() = true for text logs")
+	}
+
+	got := Diagnostics(events)
+	want := []Diagnostic{
+		{Severity: SeverityError, Summary: "Unsupported argument", Range: &Range{Filename: "main.tf", Start: Position{Line: 7}, End: Position{Line: 7}}},
+		{Severity: SeverityWarning, Summary: "Value for undeclared variable"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diagnostics() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGitHubAnnotation(t *testing.T) {
+	diagnostics := Diagnostics(Parse(structuredLog))
+
+	tests := []struct {
+		diagnostic Diagnostic
+		want       string
+	}{
+		{diagnostics[0], "::warning file=main.tf,line=12,endLine=12,col=3,endColumn=14,title=Deprecated attribute::Use tags_all instead."},
+		{diagnostics[1], `::error title=Invalid count argument::module.net.aws_subnet.a: The "count" value depends on%0Aresource attributes.`},
+		{Diagnostic{Severity: SeverityError, Summary: "a: b, c"}, "::error title=a%3A b%2C c::a: b, c"},
+	}
+	for _, tt := range tests {
+		if got := tt.diagnostic.GitHubAnnotation(); got != tt.want {
+			t.Errorf("GitHubAnnotation() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package runtimeline breaks a run down into the phases it went through, using the status
+// timestamps of the run, its plan, apply, policy checks and task stages.
+package runtimeline
+
+import (
+	"sort"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Phase names, in the order a run normally goes through them
+const (
+	PhasePending      = "Pending"
+	PhaseFetching     = "Fetching configuration"
+	PhasePrePlan      = "Pre-plan tasks"
+	PhasePlanQueue    = "Plan queue"
+	PhasePlan         = "Plan"
+	PhaseCostEstimate = "Cost estimation"
+	PhasePolicyCheck  = "Policy check"
+	PhasePostPlan     = "Post-plan tasks"
+	PhaseConfirmation = "Confirmation wait"
+	PhaseApplyQueue   = "Apply queue"
+	PhasePreApply     = "Pre-apply tasks"
+	PhaseApply        = "Apply"
+	PhasePostApply    = "Post-apply tasks"
+)
+
+// Phase is a stage of a run and how long it took. Phases that have not finished run until now.
+type Phase struct {
+	Name       string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Duration   time.Duration
+	InProgress bool
+}
+
+// Timeline is the phases of a run, ordered by when they started
+type Timeline struct {
+	CreatedAt  time.Time
+	FinishedAt time.Time
+	Duration   time.Duration
+	InProgress bool
+	Phases     []Phase
+}
+
+// Build returns the timeline of a run. The run should be read with its plan, apply and task
+// stages included. policyChecks are the legacy Sentinel policy checks of the run, if any.
+func Build(run *tfe.Run, policyChecks []*tfe.PolicyCheck, now time.Time) Timeline {
+	ts := run.StatusTimestamps
+	if ts == nil {
+		ts = &tfe.RunStatusTimestamps{}
+	}
+
+	b := &builder{now: now}
+	b.finishedAt = firstSet(ts.AppliedAt, ts.PlannedAndFinishedAt, ts.PlannedAndSavedAt,
+		ts.ErroredAt, ts.CanceledAt, ts.ForceCanceledAt, ts.DiscardedAt)
+
+	var plan tfe.PlanStatusTimestamps
+	if run.Plan != nil && run.Plan.StatusTimestamps != nil {
+		plan = *run.Plan.StatusTimestamps
+	}
+	var apply tfe.ApplyStatusTimestamps
+	if run.Apply != nil && run.Apply.StatusTimestamps != nil {
+		apply = *run.Apply.StatusTimestamps
+	}
+
+	stages := map[tfe.Stage]*tfe.TaskStage{}
+	for _, s := range run.TaskStages {
+		if s != nil {
+			stages[s.Stage] = s
+		}
+	}
+
+	// Plan side
+	prePlanStart, prePlanEnds := stageTimes(stages[tfe.PrePlan], ts.PrePlanRunningAt, ts.PrePlanCompletedAt)
+	planQueueStart := firstSet(ts.PlanQueuedAt, plan.QueuedAt)
+	planStart := firstSet(plan.StartedAt, ts.PlanningAt)
+	b.add(PhasePending, run.CreatedAt, earliest(ts.FetchingAt, prePlanStart, planQueueStart, planStart))
+	b.add(PhaseFetching, ts.FetchingAt, ts.FetchedAt)
+	b.add(PhasePrePlan, prePlanStart, prePlanEnds...)
+	b.add(PhasePlanQueue, planQueueStart, planStart)
+	b.add(PhasePlan, planStart, plan.FinishedAt, ts.PlannedAt, plan.ErroredAt, plan.CanceledAt, plan.ForceCanceledAt)
+	b.add(PhaseCostEstimate, ts.CostEstimatingAt, ts.CostEstimatedAt)
+	policyStart, policyEnd := policyCheckTimes(policyChecks)
+	b.add(PhasePolicyCheck, policyStart, policyEnd)
+	postPlanStart, postPlanEnds := stageTimes(stages[tfe.PostPlan], ts.PostPlanRunningAt, ts.PostPlanCompletedAt)
+	b.add(PhasePostPlan, postPlanStart, postPlanEnds...)
+
+	// A run waits for confirmation from when the plan side finished until it is confirmed
+	// or discarded
+	planDone := b.lastFinish()
+	waited := !ts.ConfirmedAt.IsZero() || !ts.DiscardedAt.IsZero() || (run.Actions != nil && run.Actions.IsConfirmable)
+	if waited && !planDone.IsZero() {
+		b.add(PhaseConfirmation, planDone, ts.ConfirmedAt, ts.DiscardedAt)
+	}
+
+	// Apply side
+	preApplyStart, preApplyEnds := stageTimes(stages[tfe.PreApply], time.Time{}, time.Time{})
+	applyQueueStart := firstSet(ts.ApplyQueuedAt, apply.QueuedAt)
+	applyStart := firstSet(apply.StartedAt, ts.ApplyingAt)
+	b.add(PhasePreApply, preApplyStart, preApplyEnds...)
+	b.add(PhaseApplyQueue, applyQueueStart, applyStart)
+	b.add(PhaseApply, applyStart, apply.FinishedAt, ts.AppliedAt, apply.ErroredAt, apply.CanceledAt, apply.ForceCanceledAt)
+	postApplyStart, postApplyEnds := stageTimes(stages[tfe.PostApply], time.Time{}, time.Time{})
+	b.add(PhasePostApply, postApplyStart, postApplyEnds...)
+
+	sort.SliceStable(b.phases, func(i, j int) bool {
+		return b.phases[i].StartedAt.Before(b.phases[j].StartedAt)
+	})
+
+	t := Timeline{CreatedAt: run.CreatedAt, FinishedAt: b.finishedAt, Phases: b.phases}
+	end := b.finishedAt
+	if end.IsZero() {
+		end = now
+		t.InProgress = true
+	}
+	t.Duration = nonNegative(end.Sub(run.CreatedAt))
+	return t
+}
+
+// builder collects phases, ending those without a finish time when the run finished, or now
+// while the run is in progress
+type builder struct {
+	now        time.Time
+	finishedAt time.Time
+	phases     []Phase
+}
+
+// add records a phase that started at start and finished at the first of ends that is set.
+// Phases that never started are skipped.
+func (b *builder) add(name string, start time.Time, ends ...time.Time) {
+	if start.IsZero() {
+		return
+	}
+
+	p := Phase{Name: name, StartedAt: start, FinishedAt: firstSet(ends...)}
+	end := p.FinishedAt
+	if end.IsZero() {
+		if b.finishedAt.IsZero() {
+			end = b.now
+			p.InProgress = true
+		} else {
+			end = b.finishedAt
+			p.FinishedAt = b.finishedAt
+		}
+	}
+	p.Duration = nonNegative(end.Sub(start))
+	b.phases = append(b.phases, p)
+}
+
+// lastFinish returns the latest finish time of the phases added so far
+func (b *builder) lastFinish() time.Time {
+	var last time.Time
+	for _, p := range b.phases {
+		if p.FinishedAt.After(last) {
+			last = p.FinishedAt
+		}
+	}
+	return last
+}
+
+// stageTimes returns when a task stage started and the times it may have finished at, falling
+// back to the run's own timestamps when the stage is not present
+func stageTimes(stage *tfe.TaskStage, runningAt, completedAt time.Time) (time.Time, []time.Time) {
+	if stage == nil {
+		return runningAt, []time.Time{completedAt}
+	}
+	st := stage.StatusTimestamps
+	return st.RunningAt, []time.Time{st.PassedAt, st.FailedAt, st.ErroredAt, st.CanceledAt}
+}
+
+// policyCheckTimes returns when the first policy check was queued and the last one finished.
+// The finish time is zero while any check is still running.
+func policyCheckTimes(checks []*tfe.PolicyCheck) (time.Time, time.Time) {
+	var start, end time.Time
+	running := false
+	for _, pc := range checks {
+		if pc == nil || pc.StatusTimestamps == nil || pc.StatusTimestamps.QueuedAt.IsZero() {
+			continue
+		}
+		st := pc.StatusTimestamps
+		if start.IsZero() || st.QueuedAt.Before(start) {
+			start = st.QueuedAt
+		}
+		done := firstSet(st.PassedAt, st.SoftFailedAt, st.HardFailedAt, st.ErroredAt)
+		if done.IsZero() {
+			running = true
+		} else if done.After(end) {
+			end = done
+		}
+	}
+	if running {
+		return start, time.Time{}
+	}
+	return start, end
+}
+
+// firstSet returns the first time that is not zero
+func firstSet(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// earliest returns the earliest time that is not zero
+func earliest(times ...time.Time) time.Time {
+	var first time.Time
+	for _, t := range times {
+		if !t.IsZero() && (first.IsZero() || t.Before(first)) {
+			first = t
+		}
+	}
+	return first
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package runtimeline
+
+import (
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+var t0 = time.Date(2025, 6, 2, 13, 0, 0, 0, time.UTC)
+
+// at returns a time the given number of seconds after t0
+func at(seconds int) time.Time {
+	return t0.Add(time.Duration(seconds) * time.Second)
+}
+
+type phaseWant struct {
+	name       string
+	duration   time.Duration
+	inProgress bool
+}
+
+func checkPhases(t *testing.T, got []Phase, want []phaseWant) {
+	t.Helper()
+	if len(got) != len(want) {
+		var names []string
+		for _, p := range got {
+			names = append(names, p.Name)
+		}
+		t.Fatalf("got %d phases %v, want %d", len(got), names, len(want))
+	}
+	for i, w := range want {
+		p := got[i]
+		if p.Name != w.name || p.Duration != w.duration || p.InProgress != w.inProgress {
+			t.Errorf("phase %d = {%s %s %t}, want {%s %s %t}", i, p.Name, p.Duration, p.InProgress, w.name, w.duration, w.inProgress)
+		}
+	}
+}
+
+func TestBuildAppliedRun(t *testing.T) {
+	run := &tfe.Run{
+		CreatedAt: t0,
+		StatusTimestamps: &tfe.RunStatusTimestamps{
+			PlanQueuedAt:     at(5),
+			PlanningAt:       at(20),
+			PlannedAt:        at(80),
+			CostEstimatingAt: at(80),
+			CostEstimatedAt:  at(85),
+			ConfirmedAt:      at(400),
+			ApplyQueuedAt:    at(400),
+			ApplyingAt:       at(410),
+			AppliedAt:        at(470),
+		},
+		Plan: &tfe.Plan{StatusTimestamps: &tfe.PlanStatusTimestamps{
+			QueuedAt: at(5), StartedAt: at(20), FinishedAt: at(80),
+		}},
+		Apply: &tfe.Apply{StatusTimestamps: &tfe.ApplyStatusTimestamps{
+			QueuedAt: at(400), StartedAt: at(410), FinishedAt: at(470),
+		}},
+		TaskStages: []*tfe.TaskStage{{
+			Stage:            tfe.PostPlan,
+			StatusTimestamps: tfe.TaskStageStatusTimestamps{RunningAt: at(85), PassedAt: at(100)},
+		}},
+	}
+	checks := []*tfe.PolicyCheck{{
+		StatusTimestamps: &tfe.PolicyStatusTimestamps{QueuedAt: at(85), PassedAt: at(95)},
+	}}
+
+	got := Build(run, checks, at(1000))
+	if got.InProgress || got.Duration != 470*time.Second {
+		t.Errorf("Build() duration = %s in progress %t, want 7m50s finished", got.Duration, got.InProgress)
+	}
+	checkPhases(t, got.Phases, []phaseWant{
+		{PhasePending, 5 * time.Second, false},
+		{PhasePlanQueue, 15 * time.Second, false},
+		{PhasePlan, 60 * time.Second, false},
+		{PhaseCostEstimate, 5 * time.Second, false},
+		{PhasePolicyCheck, 10 * time.Second, false},
+		{PhasePostPlan, 15 * time.Second, false},
+		{PhaseConfirmation, 300 * time.Second, false},
+		{PhaseApplyQueue, 10 * time.Second, false},
+		{PhaseApply, 60 * time.Second, false},
+	})
+}
+
+func TestBuildWaitingForConfirmation(t *testing.T) {
+	run := &tfe.Run{
+		CreatedAt: t0,
+		Actions:   &tfe.RunActions{IsConfirmable: true},
+		StatusTimestamps: &tfe.RunStatusTimestamps{
+			PlanQueuedAt: at(0),
+			PlanningAt:   at(10),
+			PlannedAt:    at(70),
+		},
+	}
+
+	got := Build(run, nil, at(130))
+	if !got.InProgress || got.Duration != 130*time.Second {
+		t.Errorf("Build() duration = %s in progress %t, want 2m10s in progress", got.Duration, got.InProgress)
+	}
+	checkPhases(t, got.Phases, []phaseWant{
+		{PhasePending, 0, false},
+		{PhasePlanQueue, 10 * time.Second, false},
+		{PhasePlan, 60 * time.Second, false},
+		{PhaseConfirmation, 60 * time.Second, true},
+	})
+}
+
+func TestBuildErroredDuringPlan(t *testing.T) {
+	run := &tfe.Run{
+		CreatedAt: t0,
+		StatusTimestamps: &tfe.RunStatusTimestamps{
+			PlanQueuedAt: at(0),
+			PlanningAt:   at(30),
+			ErroredAt:    at(45),
+		},
+	}
+
+	got := Build(run, nil, at(600))
+	if got.InProgress || !got.FinishedAt.Equal(at(45)) {
+		t.Errorf("Build() finished at %s in progress %t, want %s finished", got.FinishedAt, got.InProgress, at(45))
+	}
+	checkPhases(t, got.Phases, []phaseWant{
+		{PhasePending, 0, false},
+		{PhasePlanQueue, 30 * time.Second, false},
+		{PhasePlan, 15 * time.Second, false},
+	})
+}
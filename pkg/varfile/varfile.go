@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+// Package varfile reads workspace variables from Terraform variable definition files
+// (.tfvars and .tfvars.json) and dotenv files.
+//
+// Values of .tfvars files are parsed as HCL and must be literals. Strings, heredocs, numbers
+// and bools become plain values, anything else, such as lists, maps and objects, is an HCL
+// value with the expression text as written in the file.
+package varfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// Category of a variable, matching the workspace variable categories
+const (
+	CategoryTerraform = "terraform"
+	CategoryEnv       = "env"
+)
+
+// Variable is a variable read from a file
+type Variable struct {
+	Key      string
+	Value    string
+	Category string
+	HCL      bool
+}
+
+// ReadFile reads Terraform variables from a file, as JSON when the file has a .json extension
+// and as .tfvars otherwise
+func ReadFile(path string) ([]Variable, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var vars []Variable
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		vars, err = ParseJSON(src)
+	} else {
+		vars, err = ParseTFVars(src)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return vars, nil
+}
+
+// ReadEnvFile reads environment variables from a dotenv file
+func ReadEnvFile(path string) ([]Variable, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := ParseDotenv(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return vars, nil
+}
+
+// ParseTFVars parses the variable definitions of a .tfvars file. Every value must be a
+// literal, references to variables, locals or functions are not allowed.
+func ParseTFVars(src []byte) ([]Variable, error) {
+	file, diags := hclsyntax.ParseConfig(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, tfvarsError(diags)
+	}
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, tfvarsError(diags)
+	}
+
+	// Attributes are returned as a map, keep the order of the file
+	sorted := make([]*hcl.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		sorted = append(sorted, attr)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Range.Start.Byte < sorted[j].Range.Start.Byte
+	})
+
+	vars := make([]Variable, 0, len(sorted))
+	for _, attr := range sorted {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, tfvarsError(diags)
+		}
+		v := Variable{Key: attr.Name, Category: CategoryTerraform}
+		v.Value, v.HCL = tfvarsValue(value, attr.Expr.Range().SliceBytes(src))
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// tfvarsValue converts the value of a variable to a workspace variable value. Strings, numbers
+// and bools are plain values, numbers as written so they keep their precision. Any other value,
+// such as a list, map, object or null, is kept as HCL with the expression text from the file.
+func tfvarsValue(value cty.Value, raw []byte) (string, bool) {
+	if !value.IsNull() {
+		switch value.Type() {
+		case cty.String:
+			return value.AsString(), false
+		case cty.Bool:
+			return strconv.FormatBool(value.True()), false
+		case cty.Number:
+			return strings.TrimSpace(string(raw)), false
+		}
+	}
+	return string(raw), true
+}
+
+// tfvarsError returns the first error of diags with the line it is on
+func tfvarsError(diags hcl.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity != hcl.DiagError {
+			continue
+		}
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		if d.Subject != nil {
+			return fmt.Errorf("line %d: %s", d.Subject.Start.Line, msg)
+		}
+		return errors.New(msg)
+	}
+	return diags
+}
+
+// ParseJSON parses the variables of a .tfvars.json file. Strings, numbers and bools are plain
+// values, lists, objects and null are converted to HCL values.
+func ParseJSON(src []byte) ([]Variable, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+
+	var values map[string]interface{}
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("expected a JSON object of variables: %w", err)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	vars := make([]Variable, 0, len(keys))
+	for _, k := range keys {
+		v := Variable{Key: k, Category: CategoryTerraform}
+		switch value := values[k].(type) {
+		case string:
+			v.Value = value
+		case json.Number:
+			v.Value = value.String()
+		case bool:
+			v.Value = strconv.FormatBool(value)
+		default:
+			hclValue, err := jsonHCLValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			v.Value, v.HCL = hclValue, true
+		}
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// jsonHCLValue converts a decoded JSON list, object or null into HCL syntax. Strings are
+// written by hclwrite, which escapes template sequences so they are not interpolated.
+func jsonHCLValue(value interface{}) (string, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	ty, err := ctyjson.ImpliedType(b)
+	if err != nil {
+		return "", err
+	}
+	val, err := ctyjson.Unmarshal(b, ty)
+	if err != nil {
+		return "", err
+	}
+	return string(hclwrite.Format(hclwrite.TokensForValue(val).Bytes())), nil
+}
+
+// ParseDotenv parses the KEY=VALUE lines of a dotenv file. Values can be single quoted, taken
+// literally, or double quoted, with escapes and spanning lines. Unquoted values end at a " #"
+// comment.
+func ParseDotenv(src []byte) ([]Variable, error) {
+	lines := strings.Split(strings.ReplaceAll(string(src), "\r\n", "\n"), "\n")
+	seen := map[string]bool{}
+
+	var vars []Variable
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", lineNumber)
+		}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.HasPrefix(value, "'"):
+			end := strings.Index(value[1:], "'")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated quote for %s", lineNumber, key)
+			}
+			value = value[1 : end+1]
+		case strings.HasPrefix(value, `"`):
+			// A double quoted value continues onto the next lines until its closing quote
+			quoted := value[1:]
+			for closingQuote(quoted) < 0 && i+1 < len(lines) {
+				i++
+				quoted += "\n" + lines[i]
+			}
+			end := closingQuote(quoted)
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated quote for %s", lineNumber, key)
+			}
+			value = unescapeDotenv(quoted[:end])
+		default:
+			if comment := strings.Index(value, " #"); comment >= 0 {
+				value = strings.TrimSpace(value[:comment])
+			}
+		}
+
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: variable %s is defined more than once", lineNumber, key)
+		}
+		seen[key] = true
+		vars = append(vars, Variable{Key: key, Value: value, Category: CategoryEnv})
+	}
+	return vars, nil
+}
+
+// closingQuote returns the index of the first unescaped double quote in s, or -1
+func closingQuote(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+func unescapeDotenv(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
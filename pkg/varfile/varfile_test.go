@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+// Copyright © 2025 Tom Straub <github.com/straubt1>
+
+package varfile
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testTFVars = `# Network settings
+region        = "us-east-1" # primary region
+instance_count = 3
+enabled       = true
+greeting      = "hello \"world\"\n"
+template      = "$${not_interpolated}"
+
+// Complex values are kept as HCL
+azs = ["us-east-1a", "us-east-1b"]
+tags = {
+  Team  = "platform" # owner
+  Cost  = "shared}"
+}
+policy = <<-EOT
+    {
+      "Version": "2012-10-17"
+    }
+    EOT
+version = 1.10
+account = 012345678901
+/* a block
+   comment */
+nothing = null
+`
+
+func TestParseTFVars(t *testing.T) {
+	got, err := ParseTFVars([]byte(testTFVars))
+	if err != nil {
+		t.Fatalf("ParseTFVars() error = %v", err)
+	}
+
+	want := []Variable{
+		{Key: "region", Value: "us-east-1", Category: CategoryTerraform},
+		{Key: "instance_count", Value: "3", Category: CategoryTerraform},
+		{Key: "enabled", Value: "true", Category: CategoryTerraform},
+		{Key: "greeting", Value: "hello \"world\"\n", Category: CategoryTerraform},
+		{Key: "template", Value: "${not_interpolated}", Category: CategoryTerraform},
+		{Key: "azs", Value: `["us-east-1a", "us-east-1b"]`, Category: CategoryTerraform, HCL: true},
+		{Key: "tags", Value: "{\n  Team  = \"platform\" # owner\n  Cost  = \"shared}\"\n}", Category: CategoryTerraform, HCL: true},
+		{Key: "policy", Value: "{\n  \"Version\": \"2012-10-17\"\n}\n", Category: CategoryTerraform},
+		{Key: "version", Value: "1.10", Category: CategoryTerraform},
+		{Key: "account", Value: "012345678901", Category: CategoryTerraform},
+		{Key: "nothing", Value: "null", Category: CategoryTerraform, HCL: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTFVars() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseTFVarsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"missing equals", "region \"us-east-1\"\n"},
+		{"missing value", "region =\n"},
+		{"unclosed map", "tags = {\n  Team = \"platform\"\n"},
+		{"unterminated string", "region = \"us-east-1\n"},
+		{"unclosed heredoc", "policy = <<EOT\n{}\n"},
+		{"duplicate", "region = \"a\"\nregion = \"b\"\n"},
+		{"two on one line", "a = \"x\" b = 2\n"},
+		{"single quotes", "b = 'x'\n"},
+		{"interpolation", "name = \"${var.x}\"\n"},
+		{"reference", "size = Inf\n"},
+		{"digit separator", "count = 1_000\n"},
+		{"function call", "azs = tolist([\"a\"])\n"},
+		{"block", "tags {\n  Team = \"platform\"\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseTFVars([]byte(tt.src)); err == nil {
+				t.Errorf("ParseTFVars(%q) expected an error", tt.src)
+			}
+		})
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	got, err := ParseJSON([]byte(`{"region": "us-east-1", "count": 3, "enabled": false, "azs": ["a", "b"], "tags": {"Team": "platform"}}`))
+	if err != nil {
+		t.Fatalf("ParseJSON() error = %v", err)
+	}
+
+	want := []Variable{
+		{Key: "azs", Value: `["a", "b"]`, Category: CategoryTerraform, HCL: true},
+		{Key: "count", Value: "3", Category: CategoryTerraform},
+		{Key: "enabled", Value: "false", Category: CategoryTerraform},
+		{Key: "region", Value: "us-east-1", Category: CategoryTerraform},
+		{Key: "tags", Value: "{\n  Team = \"platform\"\n}", Category: CategoryTerraform, HCL: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseJSON() = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseJSON([]byte(`["region"]`)); err == nil {
+		t.Errorf("ParseJSON(array) expected an error")
+	}
+}
+
+func TestParseJSON_NestedStrings(t *testing.T) {
+	got, err := ParseJSON([]byte(`{"commands": ["echo ${HOME}", "%{if x}y%{endif}"], "html": {"body": "<b>&</b>"}, "none": null}`))
+	if err != nil {
+		t.Fatalf("ParseJSON() error = %v", err)
+	}
+
+	want := []Variable{
+		{Key: "commands", Value: `["echo $${HOME}", "%%{if x}y%%{endif}"]`, Category: CategoryTerraform, HCL: true},
+		{Key: "html", Value: "{\n  body = \"<b>&</b>\"\n}", Category: CategoryTerraform, HCL: true},
+		{Key: "none", Value: "null", Category: CategoryTerraform, HCL: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseJSON() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseDotenv(t *testing.T) {
+	src := "# AWS settings\n" +
+		"AWS_REGION=us-east-1\n" +
+		"export TF_LOG=debug # verbose\n" +
+		"GREETING=\"hello\\nworld\"\n" +
+		"LITERAL='a $b \\n'\n" +
+		"CERT=\"-----BEGIN-----\n" +
+		"abc\n" +
+		"-----END-----\"\n" +
+		"EMPTY=\n"
+
+	got, err := ParseDotenv([]byte(src))
+	if err != nil {
+		t.Fatalf("ParseDotenv() error = %v", err)
+	}
+
+	want := []Variable{
+		{Key: "AWS_REGION", Value: "us-east-1", Category: CategoryEnv},
+		{Key: "TF_LOG", Value: "debug", Category: CategoryEnv},
+		{Key: "GREETING", Value: "hello\nworld", Category: CategoryEnv},
+		{Key: "LITERAL", Value: `a $b \n`, Category: CategoryEnv},
+		{Key: "CERT", Value: "-----BEGIN-----\nabc\n-----END-----", Category: CategoryEnv},
+		{Key: "EMPTY", Value: "", Category: CategoryEnv},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseDotenv() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	for _, bad := range []string{"NO_EQUALS\n", "BAD KEY=1\n", "OPEN=\"never closed\n"} {
+		if _, err := ParseDotenv([]byte(bad)); err == nil {
+			t.Errorf("ParseDotenv(%q) expected an error", bad)
+		}
+	}
+}
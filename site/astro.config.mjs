@@ -42,12 +42,18 @@ export default defineConfig({
               items: [
                 { label: 'General', slug: 'commands/workspace' },
                 { label: 'Plans', slug: 'commands/workspace_plan' },
+                { label: 'Applies', slug: 'commands/workspace_apply' },
                 { label: 'Runs', slug: 'commands/workspace_run' },
                 { label: 'Variables', slug: 'commands/workspace_variable' },
                 { label: 'Configuration Versions', slug: 'commands/workspace_configurationversion' },
                 { label: 'Team', slug: 'commands/workspace_team' },
+                { label: 'Notifications', slug: 'commands/workspace_notification' },
                 { label: 'Lock', slug: 'commands/workspace_lock' },
                 { label: 'State Versions', slug: 'commands/workspace_stateversion' },
+                { label: 'Remote State', slug: 'commands/workspace_remotestate' },
+                { label: 'Run Triggers', slug: 'commands/workspace_runtrigger' },
+                { label: 'Assessments', slug: 'commands/workspace_assessment' },
+                { label: 'Resources', slug: 'commands/workspace_resource' },
               ],
             },
             {
@@ -57,6 +63,11 @@ export default defineConfig({
                 { label: 'Providers', slug: 'commands/registry_provider' },
               ],
             },
+            { label: 'Runs', slug: 'commands/run' },
+            { label: 'Run Tasks', slug: 'commands/run_task' },
+            { label: 'Export', slug: 'commands/export' },
+            { label: 'Graph', slug: 'commands/graph' },
+            { label: 'Reports', slug: 'commands/report' },
             { label: 'Releases', slug: 'commands/release' },
             {
               label: 'Admin',
@@ -36,8 +36,13 @@ type cvFileErrMsg struct{ err error }
 
 // ── Phase 7 detail message types ──────────────────────────────────────────────
 
-// runDetailLoadedMsg carries a fully-fetched run (with Plan, Apply, CV + ingress includes).
-type runDetailLoadedMsg *tfe.Run
+// runDetailLoadedMsg carries a fully-fetched run (with Plan, Apply, task stages, CV + ingress
+// includes), its policy checks for the timeline and its run task results.
+type runDetailLoadedMsg struct {
+	run          *tfe.Run
+	policyChecks []*tfe.PolicyCheck
+	taskResults  []*tfe.TaskResult
+}
 
 // svJsonLoadedMsg carries the lines of a downloaded (and pretty-printed) state JSON.
 type svJsonLoadedMsg struct{ lines []string }
@@ -298,23 +303,18 @@ func loadStateVersions(c *client.TfxClient, orgName, wsName string) tea.Cmd {
 	}
 }
 
-// loadRunDetail fetches a run with full includes (Plan, Apply, ConfigurationVersion + ingress).
+// loadRunDetail fetches a run with full includes (Plan, Apply, task stages, ConfigurationVersion +
+// ingress), its policy checks and its run task results.
 // The result silently updates selectedRun without changing the current view or loading state.
 func loadRunDetail(c *client.TfxClient, runID string) tea.Cmd {
 	return func() tea.Msg {
-		run, err := c.Client.Runs.ReadWithOptions(c.Context, runID, &tfe.RunReadOptions{
-			Include: []tfe.RunIncludeOpt{
-				tfe.RunPlan,
-				tfe.RunApply,
-				tfe.RunConfigVer,
-				tfe.RunConfigVerIngress,
-			},
-		})
+		run, policyChecks, err := data.FetchRunForTimeline(c, runID, tfe.RunConfigVer, tfe.RunConfigVerIngress)
 		if err != nil {
 			// Swallow the error silently — partial data from the list is still shown.
 			return nil
 		}
-		return runDetailLoadedMsg(run)
+		taskResults := data.FetchRunTaskResults(c, runID)
+		return runDetailLoadedMsg{run: run, policyChecks: policyChecks, taskResults: taskResults}
 	}
 }
 
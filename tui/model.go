@@ -158,8 +158,10 @@ type Model struct {
 	projDetScroll int
 
 	// Run detail state (Phase 7)
-	selectedRun  *tfe.Run
-	runDetScroll int
+	selectedRun             *tfe.Run
+	selectedRunPolicyChecks []*tfe.PolicyCheck
+	selectedRunTaskResults  []*tfe.TaskResult
+	runDetScroll            int
 
 	// Variable detail state (Phase 7)
 	selectedVar  *tfe.Variable
@@ -372,8 +374,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case runDetailLoadedMsg:
 		// Silently update the selected run with full Plan/Apply data.
 		// Does not change currentView or loading — detail view updates in-place.
-		if msg != nil {
-			m.selectedRun = (*tfe.Run)(msg)
+		if msg.run != nil {
+			m.selectedRun = msg.run
+			m.selectedRunPolicyChecks = msg.policyChecks
+			m.selectedRunTaskResults = msg.taskResults
 		}
 
 	case svJsonLoadedMsg:
@@ -662,6 +666,8 @@ func (m Model) navigateBack() (tea.Model, tea.Cmd) {
 		m.currentView = viewRuns
 		m.runDetScroll = 0
 		m.selectedRun = nil
+		m.selectedRunPolicyChecks = nil
+		m.selectedRunTaskResults = nil
 	case viewVariableDetail:
 		m.currentView = viewVariables
 		m.varDetScroll = 0
@@ -1518,6 +1524,8 @@ func (m Model) handleRunsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 		sel := filtered[m.runCursor]
 		m.selectedRun = sel
+		m.selectedRunPolicyChecks = nil
+		m.selectedRunTaskResults = nil
 		m.runDetScroll = 0
 		m.currentView = viewRunDetail
 		// Trigger a background re-fetch to populate Plan/Apply/VCS fields.
@@ -6,12 +6,14 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/straubt1/tfx/pkg/runtimeline"
 )
 
 // buildRunDetailSections assembles the sections shown in the run detail view.
-func buildRunDetailSections(run *tfe.Run) []wsDetailSection {
+func buildRunDetailSections(run *tfe.Run, policyChecks []*tfe.PolicyCheck, taskResults []*tfe.TaskResult, now time.Time) []wsDetailSection {
 	// ── General ──────────────────────────────────────────────────────────────
 	general := wsDetailSection{title: "General"}
 	general.rows = []wsDetailRow{
@@ -75,6 +77,16 @@ func buildRunDetailSections(run *tfe.Run) []wsDetailSection {
 		sections = append(sections, apply)
 	}
 
+	// ── Timeline ──────────────────────────────────────────────────────────────
+	if timeline := buildRunTimelineSection(run, policyChecks, now); len(timeline.rows) > 0 {
+		sections = append(sections, timeline)
+	}
+
+	// ── Run Tasks ─────────────────────────────────────────────────────────────
+	if len(taskResults) > 0 {
+		sections = append(sections, buildRunTaskSection(taskResults))
+	}
+
 	// ── VCS ───────────────────────────────────────────────────────────────────
 	if run.ConfigurationVersion != nil && run.ConfigurationVersion.IngressAttributes != nil {
 		ia := run.ConfigurationVersion.IngressAttributes
@@ -109,6 +121,54 @@ func buildRunDetailSections(run *tfe.Run) []wsDetailSection {
 	return sections
 }
 
+// buildRunTimelineSection lists how long the run spent in each phase and its share of the run.
+func buildRunTimelineSection(run *tfe.Run, policyChecks []*tfe.PolicyCheck, now time.Time) wsDetailSection {
+	sec := wsDetailSection{title: "Timeline"}
+	timeline := runtimeline.Build(run, policyChecks, now)
+	if len(timeline.Phases) == 0 {
+		return sec
+	}
+
+	total := timeline.Duration.Round(time.Second).String()
+	if timeline.InProgress {
+		total += " (in progress)"
+	}
+	sec.rows = append(sec.rows, wsDetailRow{"Total", total})
+	for _, p := range timeline.Phases {
+		value := p.Duration.Round(time.Second).String()
+		if timeline.Duration > 0 {
+			value += fmt.Sprintf("  (%.0f%%)", float64(p.Duration)/float64(timeline.Duration)*100)
+		}
+		if p.InProgress {
+			value += "  in progress"
+		}
+		sec.rows = append(sec.rows, wsDetailRow{p.Name, value})
+	}
+	return sec
+}
+
+// buildRunTaskSection lists the result of each run task with its message and details URL.
+func buildRunTaskSection(taskResults []*tfe.TaskResult) wsDetailSection {
+	sec := wsDetailSection{title: "Run Tasks"}
+	for _, tr := range taskResults {
+		value := string(tr.Status)
+		if tr.TaskStage != nil {
+			value += fmt.Sprintf("  (%s)", tr.TaskStage.Stage)
+		}
+		if tr.WorkspaceTaskEnforcementLevel != "" {
+			value += "  " + string(tr.WorkspaceTaskEnforcementLevel)
+		}
+		sec.rows = append(sec.rows, wsDetailRow{tr.TaskName, value})
+		if tr.Message != "" {
+			sec.rows = append(sec.rows, wsDetailRow{"  Message", tr.Message})
+		}
+		if tr.URL != "" {
+			sec.rows = append(sec.rows, wsDetailRow{"  URL", tr.URL})
+		}
+	}
+	return sec
+}
+
 // renderRunDetailContent renders the full detail view for the selected run.
 func (m Model) renderRunDetailContent() string {
 	h := m.contentHeight()
@@ -120,7 +180,7 @@ func (m Model) renderRunDetailContent() string {
 		return strings.Join(lines, "\n")
 	}
 
-	sections := buildRunDetailSections(m.selectedRun)
+	sections := buildRunDetailSections(m.selectedRun, m.selectedRunPolicyChecks, m.selectedRunTaskResults, time.Now())
 
 	var all []string
 	all = append(all, contentStyle.Width(m.innerWidth()).Render("")) // top padding